@@ -18,22 +18,35 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 
+	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
 	pkgApi "k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/dynamic"
 	restclient "k8s.io/client-go/rest"
+	clonev1alpha1 "kubevirt.io/api/clone/v1alpha1"
 	kubevirtapiv1 "kubevirt.io/api/core/v1"
+	exportv1alpha1 "kubevirt.io/api/export/v1alpha1"
+	instancetypev1alpha2 "kubevirt.io/api/instancetype/v1alpha2"
+	migrationsv1alpha1 "kubevirt.io/api/migrations/v1alpha1"
+	poolv1alpha1 "kubevirt.io/api/pool/v1alpha1"
+	snapshotv1alpha1 "kubevirt.io/api/snapshot/v1alpha1"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	uploadv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/upload/v1beta1"
 )
 
+// listPageSize is the number of objects requested per page when listing.
+const listPageSize = 500
+
 //go:generate mockgen -source=./client.go -destination=./mock/client_generated.go -package=mock
 
 type Client interface {
@@ -41,19 +54,148 @@ type Client interface {
 
 	CreateVirtualMachine(vm *kubevirtapiv1.VirtualMachine) error
 	GetVirtualMachine(namespace string, name string) (*kubevirtapiv1.VirtualMachine, error)
+	ListVirtualMachines(namespace string, options metav1.ListOptions) ([]kubevirtapiv1.VirtualMachine, error)
 	UpdateVirtualMachine(namespace string, name string, vm *kubevirtapiv1.VirtualMachine, data []byte) error
 	DeleteVirtualMachine(namespace string, name string) error
 
+	// VirtualMachine subresource operations
+
+	StartVirtualMachine(namespace string, name string) error
+	StopVirtualMachine(namespace string, name string) error
+	RestartVirtualMachine(namespace string, name string) error
+
+	// VirtualMachineInstance CRUD operations
+
+	CreateVirtualMachineInstance(vmi *kubevirtapiv1.VirtualMachineInstance) error
+	GetVirtualMachineInstance(namespace string, name string) (*kubevirtapiv1.VirtualMachineInstance, error)
+	UpdateVirtualMachineInstance(namespace string, name string, vmi *kubevirtapiv1.VirtualMachineInstance, data []byte) error
+	DeleteVirtualMachineInstance(namespace string, name string) error
+
+	// VirtualMachineInstance subresource operations
+
+	PauseVirtualMachineInstance(namespace string, name string) error
+	UnpauseVirtualMachineInstance(namespace string, name string) error
+
+	// VirtualMachineInstanceMigration CRUD operations
+
+	CreateVirtualMachineInstanceMigration(migration *kubevirtapiv1.VirtualMachineInstanceMigration) error
+	GetVirtualMachineInstanceMigration(namespace string, name string) (*kubevirtapiv1.VirtualMachineInstanceMigration, error)
+	DeleteVirtualMachineInstanceMigration(namespace string, name string) error
+
+	// MigrationPolicy CRUD operations
+
+	CreateMigrationPolicy(policy *migrationsv1alpha1.MigrationPolicy) error
+	GetMigrationPolicy(name string) (*migrationsv1alpha1.MigrationPolicy, error)
+	UpdateMigrationPolicy(name string, policy *migrationsv1alpha1.MigrationPolicy, data []byte) error
+	DeleteMigrationPolicy(name string) error
+
+	// VirtualMachineSnapshot CRUD operations
+
+	CreateVirtualMachineSnapshot(snapshot *snapshotv1alpha1.VirtualMachineSnapshot) error
+	GetVirtualMachineSnapshot(namespace string, name string) (*snapshotv1alpha1.VirtualMachineSnapshot, error)
+	UpdateVirtualMachineSnapshot(namespace string, name string, snapshot *snapshotv1alpha1.VirtualMachineSnapshot, data []byte) error
+	DeleteVirtualMachineSnapshot(namespace string, name string) error
+	GetVirtualMachineSnapshotContent(namespace string, name string) (*snapshotv1alpha1.VirtualMachineSnapshotContent, error)
+
+	// VirtualMachineRestore CRUD operations
+
+	CreateVirtualMachineRestore(restore *snapshotv1alpha1.VirtualMachineRestore) error
+	GetVirtualMachineRestore(namespace string, name string) (*snapshotv1alpha1.VirtualMachineRestore, error)
+	DeleteVirtualMachineRestore(namespace string, name string) error
+
+	// VirtualMachineClone CRUD operations
+
+	CreateVirtualMachineClone(clone *clonev1alpha1.VirtualMachineClone) error
+	GetVirtualMachineClone(namespace string, name string) (*clonev1alpha1.VirtualMachineClone, error)
+	DeleteVirtualMachineClone(namespace string, name string) error
+
+	// VirtualMachineExport CRUD operations
+
+	CreateVirtualMachineExport(export *exportv1alpha1.VirtualMachineExport) error
+	GetVirtualMachineExport(namespace string, name string) (*exportv1alpha1.VirtualMachineExport, error)
+	UpdateVirtualMachineExport(namespace string, name string, export *exportv1alpha1.VirtualMachineExport, data []byte) error
+	DeleteVirtualMachineExport(namespace string, name string) error
+
+	// VirtualMachinePool CRUD operations
+
+	CreateVirtualMachinePool(pool *poolv1alpha1.VirtualMachinePool) error
+	GetVirtualMachinePool(namespace string, name string) (*poolv1alpha1.VirtualMachinePool, error)
+	UpdateVirtualMachinePool(namespace string, name string, pool *poolv1alpha1.VirtualMachinePool, data []byte) error
+	DeleteVirtualMachinePool(namespace string, name string) error
+
+	// VirtualMachineInstanceReplicaSet CRUD operations
+
+	CreateVirtualMachineInstanceReplicaSet(replicaSet *kubevirtapiv1.VirtualMachineInstanceReplicaSet) error
+	GetVirtualMachineInstanceReplicaSet(namespace string, name string) (*kubevirtapiv1.VirtualMachineInstanceReplicaSet, error)
+	UpdateVirtualMachineInstanceReplicaSet(namespace string, name string, replicaSet *kubevirtapiv1.VirtualMachineInstanceReplicaSet, data []byte) error
+	DeleteVirtualMachineInstanceReplicaSet(namespace string, name string) error
+
+	// VirtualMachineInstancetype CRUD operations
+
+	CreateVirtualMachineInstancetype(instancetype *instancetypev1alpha2.VirtualMachineInstancetype) error
+	GetVirtualMachineInstancetype(namespace string, name string) (*instancetypev1alpha2.VirtualMachineInstancetype, error)
+	UpdateVirtualMachineInstancetype(namespace string, name string, instancetype *instancetypev1alpha2.VirtualMachineInstancetype, data []byte) error
+	DeleteVirtualMachineInstancetype(namespace string, name string) error
+
+	// VirtualMachineClusterInstancetype CRUD operations
+
+	CreateVirtualMachineClusterInstancetype(clusterInstancetype *instancetypev1alpha2.VirtualMachineClusterInstancetype) error
+	GetVirtualMachineClusterInstancetype(name string) (*instancetypev1alpha2.VirtualMachineClusterInstancetype, error)
+	UpdateVirtualMachineClusterInstancetype(name string, clusterInstancetype *instancetypev1alpha2.VirtualMachineClusterInstancetype, data []byte) error
+	DeleteVirtualMachineClusterInstancetype(name string) error
+
+	// VirtualMachinePreference CRUD operations
+
+	CreateVirtualMachinePreference(preference *instancetypev1alpha2.VirtualMachinePreference) error
+	GetVirtualMachinePreference(namespace string, name string) (*instancetypev1alpha2.VirtualMachinePreference, error)
+	UpdateVirtualMachinePreference(namespace string, name string, preference *instancetypev1alpha2.VirtualMachinePreference, data []byte) error
+	DeleteVirtualMachinePreference(namespace string, name string) error
+
+	// VirtualMachineClusterPreference CRUD operations
+
+	CreateVirtualMachineClusterPreference(clusterPreference *instancetypev1alpha2.VirtualMachineClusterPreference) error
+	GetVirtualMachineClusterPreference(name string) (*instancetypev1alpha2.VirtualMachineClusterPreference, error)
+	UpdateVirtualMachineClusterPreference(name string, clusterPreference *instancetypev1alpha2.VirtualMachineClusterPreference, data []byte) error
+	DeleteVirtualMachineClusterPreference(name string) error
+
 	// DataVolume CRUD operations
 
 	CreateDataVolume(vm *cdiv1.DataVolume) error
 	GetDataVolume(namespace string, name string) (*cdiv1.DataVolume, error)
 	UpdateDataVolume(namespace string, name string, dv *cdiv1.DataVolume, data []byte) error
 	DeleteDataVolume(namespace string, name string) error
+
+	// DataSource CRUD operations
+
+	CreateDataSource(dataSource *cdiv1.DataSource) error
+	GetDataSource(namespace string, name string) (*cdiv1.DataSource, error)
+	UpdateDataSource(namespace string, name string, dataSource *cdiv1.DataSource, data []byte) error
+	DeleteDataSource(namespace string, name string) error
+
+	// DataImportCron CRUD operations
+
+	CreateDataImportCron(cron *cdiv1.DataImportCron) error
+	GetDataImportCron(namespace string, name string) (*cdiv1.DataImportCron, error)
+	UpdateDataImportCron(namespace string, name string, cron *cdiv1.DataImportCron, data []byte) error
+	DeleteDataImportCron(namespace string, name string) error
+
+	// CDIConfig operations
+
+	GetCDIConfig(name string) (*cdiv1.CDIConfig, error)
+
+	// UploadTokenRequest operations
+
+	CreateUploadTokenRequest(tokenRequest *uploadv1beta1.UploadTokenRequest) error
+
+	// PersistentVolumeClaim operations
+
+	GetPersistentVolumeClaim(namespace string, name string) (*k8sv1.PersistentVolumeClaim, error)
+	UpdatePersistentVolumeClaim(namespace string, name string, pvc *k8sv1.PersistentVolumeClaim, data []byte) error
 }
 
 type client struct {
-	dynamicClient dynamic.Interface
+	dynamicClient     dynamic.Interface
+	subresourceClient restclient.Interface
 }
 
 // New creates our client wrapper object for the actual kubeVirt and kubernetes clients we use.
@@ -66,6 +208,18 @@ func NewClient(cfg *restclient.Config) (Client, error) {
 		return nil, fmt.Errorf(msg)
 	}
 	result.dynamicClient = c
+
+	subresourceCfg := restclient.CopyConfig(cfg)
+	subresourceCfg.GroupVersion = &schema.GroupVersion{Group: kubevirtapiv1.SubresourceGroupName, Version: kubevirtapiv1.ApiLatestVersion}
+	subresourceCfg.APIPath = "/apis"
+	subresourceCfg.NegotiatedSerializer = serializer.NewCodecFactory(runtime.NewScheme()).WithoutConversion()
+	sc, err := restclient.RESTClientFor(subresourceCfg)
+	if err != nil {
+		msg := fmt.Sprintf("Failed to create subresource client, with error: %v", err)
+		log.Printf("[Error] %s", msg)
+		return nil, fmt.Errorf(msg)
+	}
+	result.subresourceClient = sc
 	return result, nil
 }
 
@@ -97,6 +251,24 @@ func (c *client) GetVirtualMachine(namespace string, name string) (*kubevirtapiv
 	return &vm, nil
 }
 
+func (c *client) ListVirtualMachines(namespace string, options metav1.ListOptions) ([]kubevirtapiv1.VirtualMachine, error) {
+	items, err := c.listResource(namespace, vmRes(), options)
+	if err != nil {
+		msg := fmt.Sprintf("Failed to list VirtualMachines, with error: %v", err)
+		log.Printf("[Error] %s", msg)
+		return nil, fmt.Errorf(msg)
+	}
+	vms := make([]kubevirtapiv1.VirtualMachine, len(items))
+	for i, item := range items {
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.UnstructuredContent(), &vms[i]); err != nil {
+			msg := fmt.Sprintf("Failed to translate unstructed to VirtualMachine, with error: %v", err)
+			log.Printf("[Error] %s", msg)
+			return nil, fmt.Errorf(msg)
+		}
+	}
+	return vms, nil
+}
+
 func (c *client) UpdateVirtualMachine(namespace string, name string, vm *kubevirtapiv1.VirtualMachine, data []byte) error {
 	vmUpdateTypeMeta(vm)
 	return c.updateResource(namespace, name, vmRes(), vm, data)
@@ -106,6 +278,20 @@ func (c *client) DeleteVirtualMachine(namespace string, name string) error {
 	return c.deleteResource(namespace, name, vmRes())
 }
 
+// VirtualMachine subresource operations
+
+func (c *client) StartVirtualMachine(namespace string, name string) error {
+	return c.updateSubresource(namespace, name, "virtualmachines", "start", &kubevirtapiv1.StartOptions{})
+}
+
+func (c *client) StopVirtualMachine(namespace string, name string) error {
+	return c.updateSubresource(namespace, name, "virtualmachines", "stop", &kubevirtapiv1.StopOptions{})
+}
+
+func (c *client) RestartVirtualMachine(namespace string, name string) error {
+	return c.updateSubresource(namespace, name, "virtualmachines", "restart", &kubevirtapiv1.RestartOptions{})
+}
+
 func vmUpdateTypeMeta(vm *kubevirtapiv1.VirtualMachine) {
 	vm.TypeMeta = metav1.TypeMeta{
 		Kind:       "VirtualMachine",
@@ -122,92 +308,1029 @@ func vmRes() schema.GroupVersionResource {
 
 }
 
-// DataVolume CRUD operations
+// VirtualMachineInstance CRUD operations
 
-func (c *client) CreateDataVolume(dv *cdiv1.DataVolume) error {
-	dvUpdateTypeMeta(dv)
-	return c.createResource(dv, dv.Namespace, dvRes())
+func (c *client) CreateVirtualMachineInstance(vmi *kubevirtapiv1.VirtualMachineInstance) error {
+	vmiUpdateTypeMeta(vmi)
+	return c.createResource(vmi, vmi.Namespace, vmiRes())
 }
 
-func (c *client) GetDataVolume(namespace string, name string) (*cdiv1.DataVolume, error) {
-	var dv cdiv1.DataVolume
-	resp, err := c.getResource(namespace, name, dvRes())
+func (c *client) GetVirtualMachineInstance(namespace string, name string) (*kubevirtapiv1.VirtualMachineInstance, error) {
+	var vmi kubevirtapiv1.VirtualMachineInstance
+	resp, err := c.getResource(namespace, name, vmiRes())
 	if err != nil {
 		if errors.IsNotFound(err) {
-			log.Printf("[Warning] DataVolume %s not found (namespace=%s)", name, namespace)
+			log.Printf("[Warning] VirtualMachineInstance %s not found (namespace=%s)", name, namespace)
 			return nil, err
 		}
-		msg := fmt.Sprintf("Failed to get DataVolume, with error: %v", err)
+		msg := fmt.Sprintf("Failed to get VirtualMachineInstance, with error: %v", err)
 		log.Printf("[Error] %s", msg)
 		return nil, fmt.Errorf(msg)
 	}
 	unstructured := resp.UnstructuredContent()
-	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructured, &dv); err != nil {
-		msg := fmt.Sprintf("Failed to translate Unstructed to VirtualMachine, with error: %v", err)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructured, &vmi); err != nil {
+		msg := fmt.Sprintf("Failed to translate unstructed to VirtualMachineInstance, with error: %v", err)
 		log.Printf("[Error] %s", msg)
 		return nil, fmt.Errorf(msg)
 	}
-	return &dv, nil
+	return &vmi, nil
 }
 
-func (c *client) UpdateDataVolume(namespace string, name string, dv *cdiv1.DataVolume, data []byte) error {
-	dvUpdateTypeMeta(dv)
-	return c.updateResource(namespace, name, dvRes(), dv, data)
+func (c *client) UpdateVirtualMachineInstance(namespace string, name string, vmi *kubevirtapiv1.VirtualMachineInstance, data []byte) error {
+	vmiUpdateTypeMeta(vmi)
+	return c.updateResource(namespace, name, vmiRes(), vmi, data)
 }
 
-func (c *client) DeleteDataVolume(namespace string, name string) error {
-	return c.deleteResource(namespace, name, dvRes())
+func (c *client) DeleteVirtualMachineInstance(namespace string, name string) error {
+	return c.deleteResource(namespace, name, vmiRes())
 }
 
-func dvUpdateTypeMeta(dv *cdiv1.DataVolume) {
-	dv.TypeMeta = metav1.TypeMeta{
-		Kind:       "DataVolume",
-		APIVersion: cdiv1.SchemeGroupVersion.String(),
+// VirtualMachineInstance subresource operations
+
+func (c *client) PauseVirtualMachineInstance(namespace string, name string) error {
+	return c.updateSubresource(namespace, name, "virtualmachineinstances", "pause", &kubevirtapiv1.PauseOptions{})
+}
+
+func (c *client) UnpauseVirtualMachineInstance(namespace string, name string) error {
+	return c.updateSubresource(namespace, name, "virtualmachineinstances", "unpause", &kubevirtapiv1.UnpauseOptions{})
+}
+
+func vmiUpdateTypeMeta(vmi *kubevirtapiv1.VirtualMachineInstance) {
+	vmi.TypeMeta = metav1.TypeMeta{
+		Kind:       "VirtualMachineInstance",
+		APIVersion: kubevirtapiv1.GroupVersion.String(),
 	}
 }
 
-func dvRes() schema.GroupVersionResource {
+func vmiRes() schema.GroupVersionResource {
 	return schema.GroupVersionResource{
-		Group:    cdiv1.SchemeGroupVersion.Group,
-		Version:  cdiv1.SchemeGroupVersion.Version,
-		Resource: "datavolumes",
+		Group:    kubevirtapiv1.GroupVersion.Group,
+		Version:  kubevirtapiv1.GroupVersion.Version,
+		Resource: "virtualmachineinstances",
 	}
 }
 
-// Generic Resource CRUD operations
+// VirtualMachineInstanceMigration CRUD operations
 
-func (c *client) createResource(obj interface{}, namespace string, resource schema.GroupVersionResource) error {
-	resultMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+func (c *client) CreateVirtualMachineInstanceMigration(migration *kubevirtapiv1.VirtualMachineInstanceMigration) error {
+	migrationUpdateTypeMeta(migration)
+	return c.createResource(migration, migration.Namespace, migrationRes())
+}
+
+func (c *client) GetVirtualMachineInstanceMigration(namespace string, name string) (*kubevirtapiv1.VirtualMachineInstanceMigration, error) {
+	var migration kubevirtapiv1.VirtualMachineInstanceMigration
+	resp, err := c.getResource(namespace, name, migrationRes())
 	if err != nil {
-		msg := fmt.Sprintf("Failed to translate %s to Unstructed (for create operation), with error: %v", resource.Resource, err)
+		if errors.IsNotFound(err) {
+			log.Printf("[Warning] VirtualMachineInstanceMigration %s not found (namespace=%s)", name, namespace)
+			return nil, err
+		}
+		msg := fmt.Sprintf("Failed to get VirtualMachineInstanceMigration, with error: %v", err)
 		log.Printf("[Error] %s", msg)
-		return fmt.Errorf(msg)
+		return nil, fmt.Errorf(msg)
 	}
-	input := unstructured.Unstructured{}
-	input.SetUnstructuredContent(resultMap)
-	resp, err := c.dynamicClient.Resource(resource).Namespace(namespace).Create(context.Background(), &input, meta_v1.CreateOptions{})
+	unstructured := resp.UnstructuredContent()
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructured, &migration); err != nil {
+		msg := fmt.Sprintf("Failed to translate unstructed to VirtualMachineInstanceMigration, with error: %v", err)
+		log.Printf("[Error] %s", msg)
+		return nil, fmt.Errorf(msg)
+	}
+	return &migration, nil
+}
+
+func (c *client) DeleteVirtualMachineInstanceMigration(namespace string, name string) error {
+	return c.deleteResource(namespace, name, migrationRes())
+}
+
+func migrationUpdateTypeMeta(migration *kubevirtapiv1.VirtualMachineInstanceMigration) {
+	migration.TypeMeta = metav1.TypeMeta{
+		Kind:       "VirtualMachineInstanceMigration",
+		APIVersion: kubevirtapiv1.GroupVersion.String(),
+	}
+}
+
+func migrationRes() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    kubevirtapiv1.GroupVersion.Group,
+		Version:  kubevirtapiv1.GroupVersion.Version,
+		Resource: "virtualmachineinstancemigrations",
+	}
+}
+
+// MigrationPolicy CRUD operations, the policies are cluster-scoped
+
+func (c *client) CreateMigrationPolicy(policy *migrationsv1alpha1.MigrationPolicy) error {
+	migrationPolicyUpdateTypeMeta(policy)
+	return c.createResource(policy, "", migrationPolicyRes())
+}
+
+func (c *client) GetMigrationPolicy(name string) (*migrationsv1alpha1.MigrationPolicy, error) {
+	var policy migrationsv1alpha1.MigrationPolicy
+	resp, err := c.getResource("", name, migrationPolicyRes())
 	if err != nil {
-		msg := fmt.Sprintf("Failed to create %s, with error: %v", resource.Resource, err)
+		if errors.IsNotFound(err) {
+			log.Printf("[Warning] MigrationPolicy %s not found", name)
+			return nil, err
+		}
+		msg := fmt.Sprintf("Failed to get MigrationPolicy, with error: %v", err)
 		log.Printf("[Error] %s", msg)
-		return fmt.Errorf(msg)
+		return nil, fmt.Errorf(msg)
 	}
 	unstructured := resp.UnstructuredContent()
-	return runtime.DefaultUnstructuredConverter.FromUnstructured(unstructured, obj)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructured, &policy); err != nil {
+		msg := fmt.Sprintf("Failed to translate unstructed to MigrationPolicy, with error: %v", err)
+		log.Printf("[Error] %s", msg)
+		return nil, fmt.Errorf(msg)
+	}
+	return &policy, nil
 }
 
-func (c *client) getResource(namespace string, name string, resource schema.GroupVersionResource) (*unstructured.Unstructured, error) {
-	return c.dynamicClient.Resource(resource).Namespace(namespace).Get(context.Background(), name, metav1.GetOptions{})
+func (c *client) UpdateMigrationPolicy(name string, policy *migrationsv1alpha1.MigrationPolicy, data []byte) error {
+	migrationPolicyUpdateTypeMeta(policy)
+	return c.updateResource("", name, migrationPolicyRes(), policy, data)
 }
 
-func (c *client) updateResource(namespace string, name string, resource schema.GroupVersionResource, obj interface{}, data []byte) error {
-	resp, err := c.dynamicClient.Resource(resource).Namespace(namespace).Patch(context.Background(), name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+func (c *client) DeleteMigrationPolicy(name string) error {
+	return c.deleteResource("", name, migrationPolicyRes())
+}
+
+func migrationPolicyUpdateTypeMeta(policy *migrationsv1alpha1.MigrationPolicy) {
+	policy.TypeMeta = metav1.TypeMeta{
+		Kind:       "MigrationPolicy",
+		APIVersion: migrationsv1alpha1.GroupVersion.String(),
+	}
+}
+
+func migrationPolicyRes() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    migrationsv1alpha1.GroupVersion.Group,
+		Version:  migrationsv1alpha1.GroupVersion.Version,
+		Resource: "migrationpolicies",
+	}
+}
+
+// VirtualMachineSnapshot CRUD operations
+
+func (c *client) CreateVirtualMachineSnapshot(snapshot *snapshotv1alpha1.VirtualMachineSnapshot) error {
+	snapshotUpdateTypeMeta(snapshot)
+	return c.createResource(snapshot, snapshot.Namespace, snapshotRes())
+}
+
+func (c *client) GetVirtualMachineSnapshot(namespace string, name string) (*snapshotv1alpha1.VirtualMachineSnapshot, error) {
+	var snapshot snapshotv1alpha1.VirtualMachineSnapshot
+	resp, err := c.getResource(namespace, name, snapshotRes())
 	if err != nil {
-		msg := fmt.Sprintf("Failed to update %s, with error: %v", resource.Resource, err)
+		if errors.IsNotFound(err) {
+			log.Printf("[Warning] VirtualMachineSnapshot %s not found (namespace=%s)", name, namespace)
+			return nil, err
+		}
+		msg := fmt.Sprintf("Failed to get VirtualMachineSnapshot, with error: %v", err)
 		log.Printf("[Error] %s", msg)
-		return fmt.Errorf(msg)
+		return nil, fmt.Errorf(msg)
 	}
 	unstructured := resp.UnstructuredContent()
-	return runtime.DefaultUnstructuredConverter.FromUnstructured(unstructured, obj)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructured, &snapshot); err != nil {
+		msg := fmt.Sprintf("Failed to translate unstructed to VirtualMachineSnapshot, with error: %v", err)
+		log.Printf("[Error] %s", msg)
+		return nil, fmt.Errorf(msg)
+	}
+	return &snapshot, nil
+}
+
+func (c *client) UpdateVirtualMachineSnapshot(namespace string, name string, snapshot *snapshotv1alpha1.VirtualMachineSnapshot, data []byte) error {
+	snapshotUpdateTypeMeta(snapshot)
+	return c.updateResource(namespace, name, snapshotRes(), snapshot, data)
+}
+
+func (c *client) DeleteVirtualMachineSnapshot(namespace string, name string) error {
+	return c.deleteResource(namespace, name, snapshotRes())
+}
+
+func (c *client) GetVirtualMachineSnapshotContent(namespace string, name string) (*snapshotv1alpha1.VirtualMachineSnapshotContent, error) {
+	var content snapshotv1alpha1.VirtualMachineSnapshotContent
+	resp, err := c.getResource(namespace, name, snapshotContentRes())
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Printf("[Warning] VirtualMachineSnapshotContent %s not found (namespace=%s)", name, namespace)
+			return nil, err
+		}
+		msg := fmt.Sprintf("Failed to get VirtualMachineSnapshotContent, with error: %v", err)
+		log.Printf("[Error] %s", msg)
+		return nil, fmt.Errorf(msg)
+	}
+	unstructured := resp.UnstructuredContent()
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructured, &content); err != nil {
+		msg := fmt.Sprintf("Failed to translate unstructed to VirtualMachineSnapshotContent, with error: %v", err)
+		log.Printf("[Error] %s", msg)
+		return nil, fmt.Errorf(msg)
+	}
+	return &content, nil
+}
+
+func snapshotUpdateTypeMeta(snapshot *snapshotv1alpha1.VirtualMachineSnapshot) {
+	snapshot.TypeMeta = metav1.TypeMeta{
+		Kind:       "VirtualMachineSnapshot",
+		APIVersion: snapshotv1alpha1.SchemeGroupVersion.String(),
+	}
+}
+
+func snapshotRes() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    snapshotv1alpha1.SchemeGroupVersion.Group,
+		Version:  snapshotv1alpha1.SchemeGroupVersion.Version,
+		Resource: "virtualmachinesnapshots",
+	}
+}
+
+func snapshotContentRes() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    snapshotv1alpha1.SchemeGroupVersion.Group,
+		Version:  snapshotv1alpha1.SchemeGroupVersion.Version,
+		Resource: "virtualmachinesnapshotcontents",
+	}
+}
+
+// VirtualMachineRestore CRUD operations
+
+func (c *client) CreateVirtualMachineRestore(restore *snapshotv1alpha1.VirtualMachineRestore) error {
+	restoreUpdateTypeMeta(restore)
+	return c.createResource(restore, restore.Namespace, restoreRes())
+}
+
+func (c *client) GetVirtualMachineRestore(namespace string, name string) (*snapshotv1alpha1.VirtualMachineRestore, error) {
+	var restore snapshotv1alpha1.VirtualMachineRestore
+	resp, err := c.getResource(namespace, name, restoreRes())
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Printf("[Warning] VirtualMachineRestore %s not found (namespace=%s)", name, namespace)
+			return nil, err
+		}
+		msg := fmt.Sprintf("Failed to get VirtualMachineRestore, with error: %v", err)
+		log.Printf("[Error] %s", msg)
+		return nil, fmt.Errorf(msg)
+	}
+	unstructured := resp.UnstructuredContent()
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructured, &restore); err != nil {
+		msg := fmt.Sprintf("Failed to translate unstructed to VirtualMachineRestore, with error: %v", err)
+		log.Printf("[Error] %s", msg)
+		return nil, fmt.Errorf(msg)
+	}
+	return &restore, nil
+}
+
+func (c *client) DeleteVirtualMachineRestore(namespace string, name string) error {
+	return c.deleteResource(namespace, name, restoreRes())
+}
+
+func restoreUpdateTypeMeta(restore *snapshotv1alpha1.VirtualMachineRestore) {
+	restore.TypeMeta = metav1.TypeMeta{
+		Kind:       "VirtualMachineRestore",
+		APIVersion: snapshotv1alpha1.SchemeGroupVersion.String(),
+	}
+}
+
+func restoreRes() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    snapshotv1alpha1.SchemeGroupVersion.Group,
+		Version:  snapshotv1alpha1.SchemeGroupVersion.Version,
+		Resource: "virtualmachinerestores",
+	}
+}
+
+// VirtualMachineClone CRUD operations
+
+func (c *client) CreateVirtualMachineClone(clone *clonev1alpha1.VirtualMachineClone) error {
+	cloneUpdateTypeMeta(clone)
+	return c.createResource(clone, clone.Namespace, cloneRes())
+}
+
+func (c *client) GetVirtualMachineClone(namespace string, name string) (*clonev1alpha1.VirtualMachineClone, error) {
+	var clone clonev1alpha1.VirtualMachineClone
+	resp, err := c.getResource(namespace, name, cloneRes())
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Printf("[Warning] VirtualMachineClone %s not found (namespace=%s)", name, namespace)
+			return nil, err
+		}
+		msg := fmt.Sprintf("Failed to get VirtualMachineClone, with error: %v", err)
+		log.Printf("[Error] %s", msg)
+		return nil, fmt.Errorf(msg)
+	}
+	unstructured := resp.UnstructuredContent()
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructured, &clone); err != nil {
+		msg := fmt.Sprintf("Failed to translate unstructed to VirtualMachineClone, with error: %v", err)
+		log.Printf("[Error] %s", msg)
+		return nil, fmt.Errorf(msg)
+	}
+	return &clone, nil
+}
+
+func (c *client) DeleteVirtualMachineClone(namespace string, name string) error {
+	return c.deleteResource(namespace, name, cloneRes())
+}
+
+func cloneUpdateTypeMeta(clone *clonev1alpha1.VirtualMachineClone) {
+	clone.TypeMeta = metav1.TypeMeta{
+		Kind:       "VirtualMachineClone",
+		APIVersion: clonev1alpha1.SchemeGroupVersion.String(),
+	}
+}
+
+func cloneRes() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    clonev1alpha1.SchemeGroupVersion.Group,
+		Version:  clonev1alpha1.SchemeGroupVersion.Version,
+		Resource: "virtualmachineclones",
+	}
+}
+
+// VirtualMachineExport CRUD operations
+
+func (c *client) CreateVirtualMachineExport(export *exportv1alpha1.VirtualMachineExport) error {
+	exportUpdateTypeMeta(export)
+	return c.createResource(export, export.Namespace, exportRes())
+}
+
+func (c *client) GetVirtualMachineExport(namespace string, name string) (*exportv1alpha1.VirtualMachineExport, error) {
+	var export exportv1alpha1.VirtualMachineExport
+	resp, err := c.getResource(namespace, name, exportRes())
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Printf("[Warning] VirtualMachineExport %s not found (namespace=%s)", name, namespace)
+			return nil, err
+		}
+		msg := fmt.Sprintf("Failed to get VirtualMachineExport, with error: %v", err)
+		log.Printf("[Error] %s", msg)
+		return nil, fmt.Errorf(msg)
+	}
+	unstructured := resp.UnstructuredContent()
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructured, &export); err != nil {
+		msg := fmt.Sprintf("Failed to translate unstructed to VirtualMachineExport, with error: %v", err)
+		log.Printf("[Error] %s", msg)
+		return nil, fmt.Errorf(msg)
+	}
+	return &export, nil
+}
+
+func (c *client) UpdateVirtualMachineExport(namespace string, name string, export *exportv1alpha1.VirtualMachineExport, data []byte) error {
+	exportUpdateTypeMeta(export)
+	return c.updateResource(namespace, name, exportRes(), export, data)
+}
+
+func (c *client) DeleteVirtualMachineExport(namespace string, name string) error {
+	return c.deleteResource(namespace, name, exportRes())
+}
+
+func exportUpdateTypeMeta(export *exportv1alpha1.VirtualMachineExport) {
+	export.TypeMeta = metav1.TypeMeta{
+		Kind:       "VirtualMachineExport",
+		APIVersion: exportv1alpha1.SchemeGroupVersion.String(),
+	}
+}
+
+func exportRes() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    exportv1alpha1.SchemeGroupVersion.Group,
+		Version:  exportv1alpha1.SchemeGroupVersion.Version,
+		Resource: "virtualmachineexports",
+	}
+}
+
+// VirtualMachinePool CRUD operations
+
+func (c *client) CreateVirtualMachinePool(pool *poolv1alpha1.VirtualMachinePool) error {
+	poolUpdateTypeMeta(pool)
+	return c.createResource(pool, pool.Namespace, poolRes())
+}
+
+func (c *client) GetVirtualMachinePool(namespace string, name string) (*poolv1alpha1.VirtualMachinePool, error) {
+	var pool poolv1alpha1.VirtualMachinePool
+	resp, err := c.getResource(namespace, name, poolRes())
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Printf("[Warning] VirtualMachinePool %s not found (namespace=%s)", name, namespace)
+			return nil, err
+		}
+		msg := fmt.Sprintf("Failed to get VirtualMachinePool, with error: %v", err)
+		log.Printf("[Error] %s", msg)
+		return nil, fmt.Errorf(msg)
+	}
+	unstructured := resp.UnstructuredContent()
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructured, &pool); err != nil {
+		msg := fmt.Sprintf("Failed to translate unstructed to VirtualMachinePool, with error: %v", err)
+		log.Printf("[Error] %s", msg)
+		return nil, fmt.Errorf(msg)
+	}
+	return &pool, nil
+}
+
+func (c *client) UpdateVirtualMachinePool(namespace string, name string, pool *poolv1alpha1.VirtualMachinePool, data []byte) error {
+	poolUpdateTypeMeta(pool)
+	return c.updateResource(namespace, name, poolRes(), pool, data)
+}
+
+func (c *client) DeleteVirtualMachinePool(namespace string, name string) error {
+	return c.deleteResource(namespace, name, poolRes())
+}
+
+func poolUpdateTypeMeta(pool *poolv1alpha1.VirtualMachinePool) {
+	pool.TypeMeta = metav1.TypeMeta{
+		Kind:       "VirtualMachinePool",
+		APIVersion: poolv1alpha1.SchemeGroupVersion.String(),
+	}
+}
+
+func poolRes() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    poolv1alpha1.SchemeGroupVersion.Group,
+		Version:  poolv1alpha1.SchemeGroupVersion.Version,
+		Resource: "virtualmachinepools",
+	}
+}
+
+// VirtualMachineInstanceReplicaSet CRUD operations
+
+func (c *client) CreateVirtualMachineInstanceReplicaSet(replicaSet *kubevirtapiv1.VirtualMachineInstanceReplicaSet) error {
+	replicaSetUpdateTypeMeta(replicaSet)
+	return c.createResource(replicaSet, replicaSet.Namespace, replicaSetRes())
+}
+
+func (c *client) GetVirtualMachineInstanceReplicaSet(namespace string, name string) (*kubevirtapiv1.VirtualMachineInstanceReplicaSet, error) {
+	var replicaSet kubevirtapiv1.VirtualMachineInstanceReplicaSet
+	resp, err := c.getResource(namespace, name, replicaSetRes())
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Printf("[Warning] VirtualMachineInstanceReplicaSet %s not found (namespace=%s)", name, namespace)
+			return nil, err
+		}
+		msg := fmt.Sprintf("Failed to get VirtualMachineInstanceReplicaSet, with error: %v", err)
+		log.Printf("[Error] %s", msg)
+		return nil, fmt.Errorf(msg)
+	}
+	unstructured := resp.UnstructuredContent()
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructured, &replicaSet); err != nil {
+		msg := fmt.Sprintf("Failed to translate unstructed to VirtualMachineInstanceReplicaSet, with error: %v", err)
+		log.Printf("[Error] %s", msg)
+		return nil, fmt.Errorf(msg)
+	}
+	return &replicaSet, nil
+}
+
+func (c *client) UpdateVirtualMachineInstanceReplicaSet(namespace string, name string, replicaSet *kubevirtapiv1.VirtualMachineInstanceReplicaSet, data []byte) error {
+	replicaSetUpdateTypeMeta(replicaSet)
+	return c.updateResource(namespace, name, replicaSetRes(), replicaSet, data)
+}
+
+func (c *client) DeleteVirtualMachineInstanceReplicaSet(namespace string, name string) error {
+	return c.deleteResource(namespace, name, replicaSetRes())
+}
+
+func replicaSetUpdateTypeMeta(replicaSet *kubevirtapiv1.VirtualMachineInstanceReplicaSet) {
+	replicaSet.TypeMeta = metav1.TypeMeta{
+		Kind:       "VirtualMachineInstanceReplicaSet",
+		APIVersion: kubevirtapiv1.GroupVersion.String(),
+	}
+}
+
+func replicaSetRes() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    kubevirtapiv1.GroupVersion.Group,
+		Version:  kubevirtapiv1.GroupVersion.Version,
+		Resource: "virtualmachineinstancereplicasets",
+	}
+}
+
+// VirtualMachineInstancetype CRUD operations
+
+func (c *client) CreateVirtualMachineInstancetype(instancetype *instancetypev1alpha2.VirtualMachineInstancetype) error {
+	instancetypeUpdateTypeMeta(instancetype)
+	return c.createResource(instancetype, instancetype.Namespace, instancetypeRes())
+}
+
+func (c *client) GetVirtualMachineInstancetype(namespace string, name string) (*instancetypev1alpha2.VirtualMachineInstancetype, error) {
+	var instancetype instancetypev1alpha2.VirtualMachineInstancetype
+	resp, err := c.getResource(namespace, name, instancetypeRes())
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Printf("[Warning] VirtualMachineInstancetype %s not found (namespace=%s)", name, namespace)
+			return nil, err
+		}
+		msg := fmt.Sprintf("Failed to get VirtualMachineInstancetype, with error: %v", err)
+		log.Printf("[Error] %s", msg)
+		return nil, fmt.Errorf(msg)
+	}
+	unstructured := resp.UnstructuredContent()
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructured, &instancetype); err != nil {
+		msg := fmt.Sprintf("Failed to translate unstructed to VirtualMachineInstancetype, with error: %v", err)
+		log.Printf("[Error] %s", msg)
+		return nil, fmt.Errorf(msg)
+	}
+	return &instancetype, nil
+}
+
+func (c *client) UpdateVirtualMachineInstancetype(namespace string, name string, instancetype *instancetypev1alpha2.VirtualMachineInstancetype, data []byte) error {
+	instancetypeUpdateTypeMeta(instancetype)
+	return c.updateResource(namespace, name, instancetypeRes(), instancetype, data)
+}
+
+func (c *client) DeleteVirtualMachineInstancetype(namespace string, name string) error {
+	return c.deleteResource(namespace, name, instancetypeRes())
+}
+
+func instancetypeUpdateTypeMeta(instancetype *instancetypev1alpha2.VirtualMachineInstancetype) {
+	instancetype.TypeMeta = metav1.TypeMeta{
+		Kind:       "VirtualMachineInstancetype",
+		APIVersion: instancetypev1alpha2.SchemeGroupVersion.String(),
+	}
+}
+
+func instancetypeRes() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    instancetypev1alpha2.SchemeGroupVersion.Group,
+		Version:  instancetypev1alpha2.SchemeGroupVersion.Version,
+		Resource: "virtualmachineinstancetypes",
+	}
+}
+
+// VirtualMachineClusterInstancetype CRUD operations, the objects are cluster-scoped
+
+func (c *client) CreateVirtualMachineClusterInstancetype(clusterInstancetype *instancetypev1alpha2.VirtualMachineClusterInstancetype) error {
+	clusterInstancetypeUpdateTypeMeta(clusterInstancetype)
+	return c.createResource(clusterInstancetype, "", clusterInstancetypeRes())
+}
+
+func (c *client) GetVirtualMachineClusterInstancetype(name string) (*instancetypev1alpha2.VirtualMachineClusterInstancetype, error) {
+	var clusterInstancetype instancetypev1alpha2.VirtualMachineClusterInstancetype
+	resp, err := c.getResource("", name, clusterInstancetypeRes())
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Printf("[Warning] VirtualMachineClusterInstancetype %s not found", name)
+			return nil, err
+		}
+		msg := fmt.Sprintf("Failed to get VirtualMachineClusterInstancetype, with error: %v", err)
+		log.Printf("[Error] %s", msg)
+		return nil, fmt.Errorf(msg)
+	}
+	unstructured := resp.UnstructuredContent()
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructured, &clusterInstancetype); err != nil {
+		msg := fmt.Sprintf("Failed to translate unstructed to VirtualMachineClusterInstancetype, with error: %v", err)
+		log.Printf("[Error] %s", msg)
+		return nil, fmt.Errorf(msg)
+	}
+	return &clusterInstancetype, nil
+}
+
+func (c *client) UpdateVirtualMachineClusterInstancetype(name string, clusterInstancetype *instancetypev1alpha2.VirtualMachineClusterInstancetype, data []byte) error {
+	clusterInstancetypeUpdateTypeMeta(clusterInstancetype)
+	return c.updateResource("", name, clusterInstancetypeRes(), clusterInstancetype, data)
+}
+
+func (c *client) DeleteVirtualMachineClusterInstancetype(name string) error {
+	return c.deleteResource("", name, clusterInstancetypeRes())
+}
+
+func clusterInstancetypeUpdateTypeMeta(clusterInstancetype *instancetypev1alpha2.VirtualMachineClusterInstancetype) {
+	clusterInstancetype.TypeMeta = metav1.TypeMeta{
+		Kind:       "VirtualMachineClusterInstancetype",
+		APIVersion: instancetypev1alpha2.SchemeGroupVersion.String(),
+	}
+}
+
+func clusterInstancetypeRes() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    instancetypev1alpha2.SchemeGroupVersion.Group,
+		Version:  instancetypev1alpha2.SchemeGroupVersion.Version,
+		Resource: "virtualmachineclusterinstancetypes",
+	}
+}
+
+// VirtualMachinePreference CRUD operations
+
+func (c *client) CreateVirtualMachinePreference(preference *instancetypev1alpha2.VirtualMachinePreference) error {
+	preferenceUpdateTypeMeta(preference)
+	return c.createResource(preference, preference.Namespace, preferenceRes())
+}
+
+func (c *client) GetVirtualMachinePreference(namespace string, name string) (*instancetypev1alpha2.VirtualMachinePreference, error) {
+	var preference instancetypev1alpha2.VirtualMachinePreference
+	resp, err := c.getResource(namespace, name, preferenceRes())
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Printf("[Warning] VirtualMachinePreference %s not found (namespace=%s)", name, namespace)
+			return nil, err
+		}
+		msg := fmt.Sprintf("Failed to get VirtualMachinePreference, with error: %v", err)
+		log.Printf("[Error] %s", msg)
+		return nil, fmt.Errorf(msg)
+	}
+	unstructured := resp.UnstructuredContent()
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructured, &preference); err != nil {
+		msg := fmt.Sprintf("Failed to translate unstructed to VirtualMachinePreference, with error: %v", err)
+		log.Printf("[Error] %s", msg)
+		return nil, fmt.Errorf(msg)
+	}
+	return &preference, nil
+}
+
+func (c *client) UpdateVirtualMachinePreference(namespace string, name string, preference *instancetypev1alpha2.VirtualMachinePreference, data []byte) error {
+	preferenceUpdateTypeMeta(preference)
+	return c.updateResource(namespace, name, preferenceRes(), preference, data)
+}
+
+func (c *client) DeleteVirtualMachinePreference(namespace string, name string) error {
+	return c.deleteResource(namespace, name, preferenceRes())
+}
+
+func preferenceUpdateTypeMeta(preference *instancetypev1alpha2.VirtualMachinePreference) {
+	preference.TypeMeta = metav1.TypeMeta{
+		Kind:       "VirtualMachinePreference",
+		APIVersion: instancetypev1alpha2.SchemeGroupVersion.String(),
+	}
+}
+
+func preferenceRes() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    instancetypev1alpha2.SchemeGroupVersion.Group,
+		Version:  instancetypev1alpha2.SchemeGroupVersion.Version,
+		Resource: "virtualmachinepreferences",
+	}
+}
+
+// VirtualMachineClusterPreference CRUD operations, the objects are cluster-scoped
+
+func (c *client) CreateVirtualMachineClusterPreference(clusterPreference *instancetypev1alpha2.VirtualMachineClusterPreference) error {
+	clusterPreferenceUpdateTypeMeta(clusterPreference)
+	return c.createResource(clusterPreference, "", clusterPreferenceRes())
+}
+
+func (c *client) GetVirtualMachineClusterPreference(name string) (*instancetypev1alpha2.VirtualMachineClusterPreference, error) {
+	var clusterPreference instancetypev1alpha2.VirtualMachineClusterPreference
+	resp, err := c.getResource("", name, clusterPreferenceRes())
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Printf("[Warning] VirtualMachineClusterPreference %s not found", name)
+			return nil, err
+		}
+		msg := fmt.Sprintf("Failed to get VirtualMachineClusterPreference, with error: %v", err)
+		log.Printf("[Error] %s", msg)
+		return nil, fmt.Errorf(msg)
+	}
+	unstructured := resp.UnstructuredContent()
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructured, &clusterPreference); err != nil {
+		msg := fmt.Sprintf("Failed to translate unstructed to VirtualMachineClusterPreference, with error: %v", err)
+		log.Printf("[Error] %s", msg)
+		return nil, fmt.Errorf(msg)
+	}
+	return &clusterPreference, nil
+}
+
+func (c *client) UpdateVirtualMachineClusterPreference(name string, clusterPreference *instancetypev1alpha2.VirtualMachineClusterPreference, data []byte) error {
+	clusterPreferenceUpdateTypeMeta(clusterPreference)
+	return c.updateResource("", name, clusterPreferenceRes(), clusterPreference, data)
+}
+
+func (c *client) DeleteVirtualMachineClusterPreference(name string) error {
+	return c.deleteResource("", name, clusterPreferenceRes())
+}
+
+func clusterPreferenceUpdateTypeMeta(clusterPreference *instancetypev1alpha2.VirtualMachineClusterPreference) {
+	clusterPreference.TypeMeta = metav1.TypeMeta{
+		Kind:       "VirtualMachineClusterPreference",
+		APIVersion: instancetypev1alpha2.SchemeGroupVersion.String(),
+	}
+}
+
+func clusterPreferenceRes() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    instancetypev1alpha2.SchemeGroupVersion.Group,
+		Version:  instancetypev1alpha2.SchemeGroupVersion.Version,
+		Resource: "virtualmachineclusterpreferences",
+	}
+}
+
+// DataVolume CRUD operations
+
+func (c *client) CreateDataVolume(dv *cdiv1.DataVolume) error {
+	dvUpdateTypeMeta(dv)
+	return c.createResource(dv, dv.Namespace, dvRes())
+}
+
+func (c *client) GetDataVolume(namespace string, name string) (*cdiv1.DataVolume, error) {
+	var dv cdiv1.DataVolume
+	resp, err := c.getResource(namespace, name, dvRes())
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Printf("[Warning] DataVolume %s not found (namespace=%s)", name, namespace)
+			return nil, err
+		}
+		msg := fmt.Sprintf("Failed to get DataVolume, with error: %v", err)
+		log.Printf("[Error] %s", msg)
+		return nil, fmt.Errorf(msg)
+	}
+	unstructured := resp.UnstructuredContent()
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructured, &dv); err != nil {
+		msg := fmt.Sprintf("Failed to translate Unstructed to VirtualMachine, with error: %v", err)
+		log.Printf("[Error] %s", msg)
+		return nil, fmt.Errorf(msg)
+	}
+	return &dv, nil
+}
+
+func (c *client) UpdateDataVolume(namespace string, name string, dv *cdiv1.DataVolume, data []byte) error {
+	dvUpdateTypeMeta(dv)
+	return c.updateResource(namespace, name, dvRes(), dv, data)
+}
+
+func (c *client) DeleteDataVolume(namespace string, name string) error {
+	return c.deleteResource(namespace, name, dvRes())
+}
+
+func dvUpdateTypeMeta(dv *cdiv1.DataVolume) {
+	dv.TypeMeta = metav1.TypeMeta{
+		Kind:       "DataVolume",
+		APIVersion: cdiv1.SchemeGroupVersion.String(),
+	}
+}
+
+func dvRes() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    cdiv1.SchemeGroupVersion.Group,
+		Version:  cdiv1.SchemeGroupVersion.Version,
+		Resource: "datavolumes",
+	}
+}
+
+// DataSource CRUD operations
+
+func (c *client) CreateDataSource(dataSource *cdiv1.DataSource) error {
+	dataSourceUpdateTypeMeta(dataSource)
+	return c.createResource(dataSource, dataSource.Namespace, dataSourceRes())
+}
+
+func (c *client) GetDataSource(namespace string, name string) (*cdiv1.DataSource, error) {
+	var dataSource cdiv1.DataSource
+	resp, err := c.getResource(namespace, name, dataSourceRes())
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Printf("[Warning] DataSource %s not found (namespace=%s)", name, namespace)
+			return nil, err
+		}
+		msg := fmt.Sprintf("Failed to get DataSource, with error: %v", err)
+		log.Printf("[Error] %s", msg)
+		return nil, fmt.Errorf(msg)
+	}
+	unstructured := resp.UnstructuredContent()
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructured, &dataSource); err != nil {
+		msg := fmt.Sprintf("Failed to translate unstructed to DataSource, with error: %v", err)
+		log.Printf("[Error] %s", msg)
+		return nil, fmt.Errorf(msg)
+	}
+	return &dataSource, nil
+}
+
+func (c *client) UpdateDataSource(namespace string, name string, dataSource *cdiv1.DataSource, data []byte) error {
+	dataSourceUpdateTypeMeta(dataSource)
+	return c.updateResource(namespace, name, dataSourceRes(), dataSource, data)
+}
+
+func (c *client) DeleteDataSource(namespace string, name string) error {
+	return c.deleteResource(namespace, name, dataSourceRes())
+}
+
+func dataSourceUpdateTypeMeta(dataSource *cdiv1.DataSource) {
+	dataSource.TypeMeta = metav1.TypeMeta{
+		Kind:       "DataSource",
+		APIVersion: cdiv1.SchemeGroupVersion.String(),
+	}
+}
+
+func dataSourceRes() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    cdiv1.SchemeGroupVersion.Group,
+		Version:  cdiv1.SchemeGroupVersion.Version,
+		Resource: "datasources",
+	}
+}
+
+// DataImportCron CRUD operations
+
+func (c *client) CreateDataImportCron(cron *cdiv1.DataImportCron) error {
+	cronUpdateTypeMeta(cron)
+	return c.createResource(cron, cron.Namespace, cronRes())
+}
+
+func (c *client) GetDataImportCron(namespace string, name string) (*cdiv1.DataImportCron, error) {
+	var cron cdiv1.DataImportCron
+	resp, err := c.getResource(namespace, name, cronRes())
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Printf("[Warning] DataImportCron %s not found (namespace=%s)", name, namespace)
+			return nil, err
+		}
+		msg := fmt.Sprintf("Failed to get DataImportCron, with error: %v", err)
+		log.Printf("[Error] %s", msg)
+		return nil, fmt.Errorf(msg)
+	}
+	unstructured := resp.UnstructuredContent()
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructured, &cron); err != nil {
+		msg := fmt.Sprintf("Failed to translate unstructed to DataImportCron, with error: %v", err)
+		log.Printf("[Error] %s", msg)
+		return nil, fmt.Errorf(msg)
+	}
+	return &cron, nil
+}
+
+func (c *client) UpdateDataImportCron(namespace string, name string, cron *cdiv1.DataImportCron, data []byte) error {
+	cronUpdateTypeMeta(cron)
+	return c.updateResource(namespace, name, cronRes(), cron, data)
+}
+
+func (c *client) DeleteDataImportCron(namespace string, name string) error {
+	return c.deleteResource(namespace, name, cronRes())
+}
+
+func cronUpdateTypeMeta(cron *cdiv1.DataImportCron) {
+	cron.TypeMeta = metav1.TypeMeta{
+		Kind:       "DataImportCron",
+		APIVersion: cdiv1.SchemeGroupVersion.String(),
+	}
+}
+
+func cronRes() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    cdiv1.SchemeGroupVersion.Group,
+		Version:  cdiv1.SchemeGroupVersion.Version,
+		Resource: "dataimportcrons",
+	}
+}
+
+// CDIConfig operations
+
+func (c *client) GetCDIConfig(name string) (*cdiv1.CDIConfig, error) {
+	var config cdiv1.CDIConfig
+	resp, err := c.getResource("", name, cdiConfigRes())
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Printf("[Warning] CDIConfig %s not found", name)
+			return nil, err
+		}
+		msg := fmt.Sprintf("Failed to get CDIConfig, with error: %v", err)
+		log.Printf("[Error] %s", msg)
+		return nil, fmt.Errorf(msg)
+	}
+	unstructured := resp.UnstructuredContent()
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructured, &config); err != nil {
+		msg := fmt.Sprintf("Failed to translate unstructed to CDIConfig, with error: %v", err)
+		log.Printf("[Error] %s", msg)
+		return nil, fmt.Errorf(msg)
+	}
+	return &config, nil
+}
+
+func cdiConfigRes() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    cdiv1.SchemeGroupVersion.Group,
+		Version:  cdiv1.SchemeGroupVersion.Version,
+		Resource: "cdiconfigs",
+	}
+}
+
+// UploadTokenRequest operations
+
+// CreateUploadTokenRequest asks CDI for a token authorizing an upload to the
+// PVC named in the request, the token is returned in its status.
+func (c *client) CreateUploadTokenRequest(tokenRequest *uploadv1beta1.UploadTokenRequest) error {
+	tokenRequestUpdateTypeMeta(tokenRequest)
+	return c.createResource(tokenRequest, tokenRequest.Namespace, tokenRequestRes())
+}
+
+func tokenRequestUpdateTypeMeta(tokenRequest *uploadv1beta1.UploadTokenRequest) {
+	tokenRequest.TypeMeta = metav1.TypeMeta{
+		Kind:       "UploadTokenRequest",
+		APIVersion: uploadv1beta1.SchemeGroupVersion.String(),
+	}
+}
+
+func tokenRequestRes() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    uploadv1beta1.SchemeGroupVersion.Group,
+		Version:  uploadv1beta1.SchemeGroupVersion.Version,
+		Resource: "uploadtokenrequests",
+	}
+}
+
+// PersistentVolumeClaim operations
+
+func (c *client) GetPersistentVolumeClaim(namespace string, name string) (*k8sv1.PersistentVolumeClaim, error) {
+	var pvc k8sv1.PersistentVolumeClaim
+	resp, err := c.getResource(namespace, name, pvcRes())
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Printf("[Warning] PersistentVolumeClaim %s not found (namespace=%s)", name, namespace)
+			return nil, err
+		}
+		msg := fmt.Sprintf("Failed to get PersistentVolumeClaim, with error: %v", err)
+		log.Printf("[Error] %s", msg)
+		return nil, fmt.Errorf(msg)
+	}
+	unstructured := resp.UnstructuredContent()
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructured, &pvc); err != nil {
+		msg := fmt.Sprintf("Failed to translate unstructed to PersistentVolumeClaim, with error: %v", err)
+		log.Printf("[Error] %s", msg)
+		return nil, fmt.Errorf(msg)
+	}
+	return &pvc, nil
+}
+
+func (c *client) UpdatePersistentVolumeClaim(namespace string, name string, pvc *k8sv1.PersistentVolumeClaim, data []byte) error {
+	pvc.TypeMeta = metav1.TypeMeta{
+		Kind:       "PersistentVolumeClaim",
+		APIVersion: k8sv1.SchemeGroupVersion.String(),
+	}
+	return c.updateResource(namespace, name, pvcRes(), pvc, data)
+}
+
+func pvcRes() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    k8sv1.SchemeGroupVersion.Group,
+		Version:  k8sv1.SchemeGroupVersion.Version,
+		Resource: "persistentvolumeclaims",
+	}
+}
+
+// Generic Resource CRUD operations
+
+func (c *client) createResource(obj interface{}, namespace string, resource schema.GroupVersionResource) error {
+	resultMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		msg := fmt.Sprintf("Failed to translate %s to Unstructed (for create operation), with error: %v", resource.Resource, err)
+		log.Printf("[Error] %s", msg)
+		return fmt.Errorf(msg)
+	}
+	input := unstructured.Unstructured{}
+	input.SetUnstructuredContent(resultMap)
+	resp, err := c.dynamicClient.Resource(resource).Namespace(namespace).Create(context.Background(), &input, meta_v1.CreateOptions{})
+	if err != nil {
+		msg := fmt.Sprintf("Failed to create %s, with error: %v", resource.Resource, err)
+		log.Printf("[Error] %s", msg)
+		return fmt.Errorf(msg)
+	}
+	unstructured := resp.UnstructuredContent()
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(unstructured, obj)
+}
+
+func (c *client) getResource(namespace string, name string, resource schema.GroupVersionResource) (*unstructured.Unstructured, error) {
+	return c.dynamicClient.Resource(resource).Namespace(namespace).Get(context.Background(), name, metav1.GetOptions{})
+}
+
+// listResource lists every object matching the options, following the continue token
+// from page to page. An empty namespace lists the objects of all namespaces.
+func (c *client) listResource(namespace string, resource schema.GroupVersionResource, options metav1.ListOptions) ([]unstructured.Unstructured, error) {
+	if options.Limit == 0 {
+		options.Limit = listPageSize
+	}
+
+	var items []unstructured.Unstructured
+	for {
+		resp, err := c.dynamicClient.Resource(resource).Namespace(namespace).List(context.Background(), options)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, resp.Items...)
+
+		options.Continue = resp.GetContinue()
+		if options.Continue == "" {
+			return items, nil
+		}
+	}
+}
+
+func (c *client) updateResource(namespace string, name string, resource schema.GroupVersionResource, obj interface{}, data []byte) error {
+	resp, err := c.dynamicClient.Resource(resource).Namespace(namespace).Patch(context.Background(), name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	if err != nil {
+		msg := fmt.Sprintf("Failed to update %s, with error: %v", resource.Resource, err)
+		log.Printf("[Error] %s", msg)
+		return fmt.Errorf(msg)
+	}
+	unstructured := resp.UnstructuredContent()
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(unstructured, obj)
+}
+
+// updateSubresource calls a subresources.kubevirt.io endpoint of the object, such as start or pause.
+func (c *client) updateSubresource(namespace string, name string, resource string, subresource string, options interface{}) error {
+	body, err := json.Marshal(options)
+	if err != nil {
+		msg := fmt.Sprintf("Failed to marshal %s options, with error: %v", subresource, err)
+		log.Printf("[Error] %s", msg)
+		return fmt.Errorf(msg)
+	}
+	err = c.subresourceClient.Put().Namespace(namespace).Resource(resource).Name(name).SubResource(subresource).Body(body).Do(context.Background()).Error()
+	if err != nil {
+		msg := fmt.Sprintf("Failed to %s %s %s, with error: %v", subresource, resource, name, err)
+		log.Printf("[Error] %s", msg)
+		return fmt.Errorf(msg)
+	}
+	return nil
 }
 
 func (c *client) deleteResource(namespace string, name string, resource schema.GroupVersionResource) error {
@@ -8,8 +8,17 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
-	v1 "kubevirt.io/api/core/v1"
+	v1 "k8s.io/api/core/v1"
+	v10 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1alpha1 "kubevirt.io/api/clone/v1alpha1"
+	v11 "kubevirt.io/api/core/v1"
+	v1alpha10 "kubevirt.io/api/export/v1alpha1"
+	v1alpha2 "kubevirt.io/api/instancetype/v1alpha2"
+	v1alpha11 "kubevirt.io/api/migrations/v1alpha1"
+	v1alpha12 "kubevirt.io/api/pool/v1alpha1"
+	v1alpha13 "kubevirt.io/api/snapshot/v1alpha1"
 	v1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	v1beta10 "kubevirt.io/containerized-data-importer-api/pkg/apis/upload/v1beta1"
 )
 
 // MockClient is a mock of Client interface.
@@ -35,6 +44,34 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// CreateDataImportCron mocks base method.
+func (m *MockClient) CreateDataImportCron(cron *v1beta1.DataImportCron) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDataImportCron", cron)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDataImportCron indicates an expected call of CreateDataImportCron.
+func (mr *MockClientMockRecorder) CreateDataImportCron(cron interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDataImportCron", reflect.TypeOf((*MockClient)(nil).CreateDataImportCron), cron)
+}
+
+// CreateDataSource mocks base method.
+func (m *MockClient) CreateDataSource(dataSource *v1beta1.DataSource) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDataSource", dataSource)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDataSource indicates an expected call of CreateDataSource.
+func (mr *MockClientMockRecorder) CreateDataSource(dataSource interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDataSource", reflect.TypeOf((*MockClient)(nil).CreateDataSource), dataSource)
+}
+
 // CreateDataVolume mocks base method.
 func (m *MockClient) CreateDataVolume(vm *v1beta1.DataVolume) error {
 	m.ctrl.T.Helper()
@@ -49,8 +86,36 @@ func (mr *MockClientMockRecorder) CreateDataVolume(vm interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDataVolume", reflect.TypeOf((*MockClient)(nil).CreateDataVolume), vm)
 }
 
+// CreateMigrationPolicy mocks base method.
+func (m *MockClient) CreateMigrationPolicy(policy *v1alpha11.MigrationPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMigrationPolicy", policy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateMigrationPolicy indicates an expected call of CreateMigrationPolicy.
+func (mr *MockClientMockRecorder) CreateMigrationPolicy(policy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMigrationPolicy", reflect.TypeOf((*MockClient)(nil).CreateMigrationPolicy), policy)
+}
+
+// CreateUploadTokenRequest mocks base method.
+func (m *MockClient) CreateUploadTokenRequest(tokenRequest *v1beta10.UploadTokenRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUploadTokenRequest", tokenRequest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateUploadTokenRequest indicates an expected call of CreateUploadTokenRequest.
+func (mr *MockClientMockRecorder) CreateUploadTokenRequest(tokenRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUploadTokenRequest", reflect.TypeOf((*MockClient)(nil).CreateUploadTokenRequest), tokenRequest)
+}
+
 // CreateVirtualMachine mocks base method.
-func (m *MockClient) CreateVirtualMachine(vm *v1.VirtualMachine) error {
+func (m *MockClient) CreateVirtualMachine(vm *v11.VirtualMachine) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateVirtualMachine", vm)
 	ret0, _ := ret[0].(error)
@@ -63,6 +128,202 @@ func (mr *MockClientMockRecorder) CreateVirtualMachine(vm interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVirtualMachine", reflect.TypeOf((*MockClient)(nil).CreateVirtualMachine), vm)
 }
 
+// CreateVirtualMachineClone mocks base method.
+func (m *MockClient) CreateVirtualMachineClone(clone *v1alpha1.VirtualMachineClone) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVirtualMachineClone", clone)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateVirtualMachineClone indicates an expected call of CreateVirtualMachineClone.
+func (mr *MockClientMockRecorder) CreateVirtualMachineClone(clone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVirtualMachineClone", reflect.TypeOf((*MockClient)(nil).CreateVirtualMachineClone), clone)
+}
+
+// CreateVirtualMachineClusterInstancetype mocks base method.
+func (m *MockClient) CreateVirtualMachineClusterInstancetype(clusterInstancetype *v1alpha2.VirtualMachineClusterInstancetype) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVirtualMachineClusterInstancetype", clusterInstancetype)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateVirtualMachineClusterInstancetype indicates an expected call of CreateVirtualMachineClusterInstancetype.
+func (mr *MockClientMockRecorder) CreateVirtualMachineClusterInstancetype(clusterInstancetype interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVirtualMachineClusterInstancetype", reflect.TypeOf((*MockClient)(nil).CreateVirtualMachineClusterInstancetype), clusterInstancetype)
+}
+
+// CreateVirtualMachineClusterPreference mocks base method.
+func (m *MockClient) CreateVirtualMachineClusterPreference(clusterPreference *v1alpha2.VirtualMachineClusterPreference) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVirtualMachineClusterPreference", clusterPreference)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateVirtualMachineClusterPreference indicates an expected call of CreateVirtualMachineClusterPreference.
+func (mr *MockClientMockRecorder) CreateVirtualMachineClusterPreference(clusterPreference interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVirtualMachineClusterPreference", reflect.TypeOf((*MockClient)(nil).CreateVirtualMachineClusterPreference), clusterPreference)
+}
+
+// CreateVirtualMachineExport mocks base method.
+func (m *MockClient) CreateVirtualMachineExport(export *v1alpha10.VirtualMachineExport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVirtualMachineExport", export)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateVirtualMachineExport indicates an expected call of CreateVirtualMachineExport.
+func (mr *MockClientMockRecorder) CreateVirtualMachineExport(export interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVirtualMachineExport", reflect.TypeOf((*MockClient)(nil).CreateVirtualMachineExport), export)
+}
+
+// CreateVirtualMachineInstance mocks base method.
+func (m *MockClient) CreateVirtualMachineInstance(vmi *v11.VirtualMachineInstance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVirtualMachineInstance", vmi)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateVirtualMachineInstance indicates an expected call of CreateVirtualMachineInstance.
+func (mr *MockClientMockRecorder) CreateVirtualMachineInstance(vmi interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVirtualMachineInstance", reflect.TypeOf((*MockClient)(nil).CreateVirtualMachineInstance), vmi)
+}
+
+// CreateVirtualMachineInstanceMigration mocks base method.
+func (m *MockClient) CreateVirtualMachineInstanceMigration(migration *v11.VirtualMachineInstanceMigration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVirtualMachineInstanceMigration", migration)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateVirtualMachineInstanceMigration indicates an expected call of CreateVirtualMachineInstanceMigration.
+func (mr *MockClientMockRecorder) CreateVirtualMachineInstanceMigration(migration interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVirtualMachineInstanceMigration", reflect.TypeOf((*MockClient)(nil).CreateVirtualMachineInstanceMigration), migration)
+}
+
+// CreateVirtualMachineInstanceReplicaSet mocks base method.
+func (m *MockClient) CreateVirtualMachineInstanceReplicaSet(replicaSet *v11.VirtualMachineInstanceReplicaSet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVirtualMachineInstanceReplicaSet", replicaSet)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateVirtualMachineInstanceReplicaSet indicates an expected call of CreateVirtualMachineInstanceReplicaSet.
+func (mr *MockClientMockRecorder) CreateVirtualMachineInstanceReplicaSet(replicaSet interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVirtualMachineInstanceReplicaSet", reflect.TypeOf((*MockClient)(nil).CreateVirtualMachineInstanceReplicaSet), replicaSet)
+}
+
+// CreateVirtualMachineInstancetype mocks base method.
+func (m *MockClient) CreateVirtualMachineInstancetype(instancetype *v1alpha2.VirtualMachineInstancetype) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVirtualMachineInstancetype", instancetype)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateVirtualMachineInstancetype indicates an expected call of CreateVirtualMachineInstancetype.
+func (mr *MockClientMockRecorder) CreateVirtualMachineInstancetype(instancetype interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVirtualMachineInstancetype", reflect.TypeOf((*MockClient)(nil).CreateVirtualMachineInstancetype), instancetype)
+}
+
+// CreateVirtualMachinePool mocks base method.
+func (m *MockClient) CreateVirtualMachinePool(pool *v1alpha12.VirtualMachinePool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVirtualMachinePool", pool)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateVirtualMachinePool indicates an expected call of CreateVirtualMachinePool.
+func (mr *MockClientMockRecorder) CreateVirtualMachinePool(pool interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVirtualMachinePool", reflect.TypeOf((*MockClient)(nil).CreateVirtualMachinePool), pool)
+}
+
+// CreateVirtualMachinePreference mocks base method.
+func (m *MockClient) CreateVirtualMachinePreference(preference *v1alpha2.VirtualMachinePreference) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVirtualMachinePreference", preference)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateVirtualMachinePreference indicates an expected call of CreateVirtualMachinePreference.
+func (mr *MockClientMockRecorder) CreateVirtualMachinePreference(preference interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVirtualMachinePreference", reflect.TypeOf((*MockClient)(nil).CreateVirtualMachinePreference), preference)
+}
+
+// CreateVirtualMachineRestore mocks base method.
+func (m *MockClient) CreateVirtualMachineRestore(restore *v1alpha13.VirtualMachineRestore) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVirtualMachineRestore", restore)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateVirtualMachineRestore indicates an expected call of CreateVirtualMachineRestore.
+func (mr *MockClientMockRecorder) CreateVirtualMachineRestore(restore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVirtualMachineRestore", reflect.TypeOf((*MockClient)(nil).CreateVirtualMachineRestore), restore)
+}
+
+// CreateVirtualMachineSnapshot mocks base method.
+func (m *MockClient) CreateVirtualMachineSnapshot(snapshot *v1alpha13.VirtualMachineSnapshot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVirtualMachineSnapshot", snapshot)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateVirtualMachineSnapshot indicates an expected call of CreateVirtualMachineSnapshot.
+func (mr *MockClientMockRecorder) CreateVirtualMachineSnapshot(snapshot interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVirtualMachineSnapshot", reflect.TypeOf((*MockClient)(nil).CreateVirtualMachineSnapshot), snapshot)
+}
+
+// DeleteDataImportCron mocks base method.
+func (m *MockClient) DeleteDataImportCron(namespace, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDataImportCron", namespace, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDataImportCron indicates an expected call of DeleteDataImportCron.
+func (mr *MockClientMockRecorder) DeleteDataImportCron(namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDataImportCron", reflect.TypeOf((*MockClient)(nil).DeleteDataImportCron), namespace, name)
+}
+
+// DeleteDataSource mocks base method.
+func (m *MockClient) DeleteDataSource(namespace, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDataSource", namespace, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDataSource indicates an expected call of DeleteDataSource.
+func (mr *MockClientMockRecorder) DeleteDataSource(namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDataSource", reflect.TypeOf((*MockClient)(nil).DeleteDataSource), namespace, name)
+}
+
 // DeleteDataVolume mocks base method.
 func (m *MockClient) DeleteDataVolume(namespace, name string) error {
 	m.ctrl.T.Helper()
@@ -77,6 +338,20 @@ func (mr *MockClientMockRecorder) DeleteDataVolume(namespace, name interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDataVolume", reflect.TypeOf((*MockClient)(nil).DeleteDataVolume), namespace, name)
 }
 
+// DeleteMigrationPolicy mocks base method.
+func (m *MockClient) DeleteMigrationPolicy(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMigrationPolicy", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMigrationPolicy indicates an expected call of DeleteMigrationPolicy.
+func (mr *MockClientMockRecorder) DeleteMigrationPolicy(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMigrationPolicy", reflect.TypeOf((*MockClient)(nil).DeleteMigrationPolicy), name)
+}
+
 // DeleteVirtualMachine mocks base method.
 func (m *MockClient) DeleteVirtualMachine(namespace, name string) error {
 	m.ctrl.T.Helper()
@@ -91,60 +366,765 @@ func (mr *MockClientMockRecorder) DeleteVirtualMachine(namespace, name interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVirtualMachine", reflect.TypeOf((*MockClient)(nil).DeleteVirtualMachine), namespace, name)
 }
 
-// GetDataVolume mocks base method.
-func (m *MockClient) GetDataVolume(namespace, name string) (*v1beta1.DataVolume, error) {
+// DeleteVirtualMachineClone mocks base method.
+func (m *MockClient) DeleteVirtualMachineClone(namespace, name string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDataVolume", namespace, name)
-	ret0, _ := ret[0].(*v1beta1.DataVolume)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteVirtualMachineClone", namespace, name)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetDataVolume indicates an expected call of GetDataVolume.
-func (mr *MockClientMockRecorder) GetDataVolume(namespace, name interface{}) *gomock.Call {
+// DeleteVirtualMachineClone indicates an expected call of DeleteVirtualMachineClone.
+func (mr *MockClientMockRecorder) DeleteVirtualMachineClone(namespace, name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataVolume", reflect.TypeOf((*MockClient)(nil).GetDataVolume), namespace, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVirtualMachineClone", reflect.TypeOf((*MockClient)(nil).DeleteVirtualMachineClone), namespace, name)
 }
 
-// GetVirtualMachine mocks base method.
-func (m *MockClient) GetVirtualMachine(namespace, name string) (*v1.VirtualMachine, error) {
+// DeleteVirtualMachineClusterInstancetype mocks base method.
+func (m *MockClient) DeleteVirtualMachineClusterInstancetype(name string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetVirtualMachine", namespace, name)
-	ret0, _ := ret[0].(*v1.VirtualMachine)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteVirtualMachineClusterInstancetype", name)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetVirtualMachine indicates an expected call of GetVirtualMachine.
-func (mr *MockClientMockRecorder) GetVirtualMachine(namespace, name interface{}) *gomock.Call {
+// DeleteVirtualMachineClusterInstancetype indicates an expected call of DeleteVirtualMachineClusterInstancetype.
+func (mr *MockClientMockRecorder) DeleteVirtualMachineClusterInstancetype(name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachine", reflect.TypeOf((*MockClient)(nil).GetVirtualMachine), namespace, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVirtualMachineClusterInstancetype", reflect.TypeOf((*MockClient)(nil).DeleteVirtualMachineClusterInstancetype), name)
 }
 
-// UpdateDataVolume mocks base method.
-func (m *MockClient) UpdateDataVolume(namespace, name string, dv *v1beta1.DataVolume, data []byte) error {
+// DeleteVirtualMachineClusterPreference mocks base method.
+func (m *MockClient) DeleteVirtualMachineClusterPreference(name string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateDataVolume", namespace, name, dv, data)
+	ret := m.ctrl.Call(m, "DeleteVirtualMachineClusterPreference", name)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateDataVolume indicates an expected call of UpdateDataVolume.
-func (mr *MockClientMockRecorder) UpdateDataVolume(namespace, name, dv, data interface{}) *gomock.Call {
+// DeleteVirtualMachineClusterPreference indicates an expected call of DeleteVirtualMachineClusterPreference.
+func (mr *MockClientMockRecorder) DeleteVirtualMachineClusterPreference(name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDataVolume", reflect.TypeOf((*MockClient)(nil).UpdateDataVolume), namespace, name, dv, data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVirtualMachineClusterPreference", reflect.TypeOf((*MockClient)(nil).DeleteVirtualMachineClusterPreference), name)
 }
 
-// UpdateVirtualMachine mocks base method.
-func (m *MockClient) UpdateVirtualMachine(namespace, name string, vm *v1.VirtualMachine, data []byte) error {
+// DeleteVirtualMachineExport mocks base method.
+func (m *MockClient) DeleteVirtualMachineExport(namespace, name string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateVirtualMachine", namespace, name, vm, data)
+	ret := m.ctrl.Call(m, "DeleteVirtualMachineExport", namespace, name)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateVirtualMachine indicates an expected call of UpdateVirtualMachine.
-func (mr *MockClientMockRecorder) UpdateVirtualMachine(namespace, name, vm, data interface{}) *gomock.Call {
+// DeleteVirtualMachineExport indicates an expected call of DeleteVirtualMachineExport.
+func (mr *MockClientMockRecorder) DeleteVirtualMachineExport(namespace, name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVirtualMachine", reflect.TypeOf((*MockClient)(nil).UpdateVirtualMachine), namespace, name, vm, data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVirtualMachineExport", reflect.TypeOf((*MockClient)(nil).DeleteVirtualMachineExport), namespace, name)
+}
+
+// DeleteVirtualMachineInstance mocks base method.
+func (m *MockClient) DeleteVirtualMachineInstance(namespace, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVirtualMachineInstance", namespace, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVirtualMachineInstance indicates an expected call of DeleteVirtualMachineInstance.
+func (mr *MockClientMockRecorder) DeleteVirtualMachineInstance(namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVirtualMachineInstance", reflect.TypeOf((*MockClient)(nil).DeleteVirtualMachineInstance), namespace, name)
+}
+
+// DeleteVirtualMachineInstanceMigration mocks base method.
+func (m *MockClient) DeleteVirtualMachineInstanceMigration(namespace, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVirtualMachineInstanceMigration", namespace, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVirtualMachineInstanceMigration indicates an expected call of DeleteVirtualMachineInstanceMigration.
+func (mr *MockClientMockRecorder) DeleteVirtualMachineInstanceMigration(namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVirtualMachineInstanceMigration", reflect.TypeOf((*MockClient)(nil).DeleteVirtualMachineInstanceMigration), namespace, name)
+}
+
+// DeleteVirtualMachineInstanceReplicaSet mocks base method.
+func (m *MockClient) DeleteVirtualMachineInstanceReplicaSet(namespace, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVirtualMachineInstanceReplicaSet", namespace, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVirtualMachineInstanceReplicaSet indicates an expected call of DeleteVirtualMachineInstanceReplicaSet.
+func (mr *MockClientMockRecorder) DeleteVirtualMachineInstanceReplicaSet(namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVirtualMachineInstanceReplicaSet", reflect.TypeOf((*MockClient)(nil).DeleteVirtualMachineInstanceReplicaSet), namespace, name)
+}
+
+// DeleteVirtualMachineInstancetype mocks base method.
+func (m *MockClient) DeleteVirtualMachineInstancetype(namespace, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVirtualMachineInstancetype", namespace, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVirtualMachineInstancetype indicates an expected call of DeleteVirtualMachineInstancetype.
+func (mr *MockClientMockRecorder) DeleteVirtualMachineInstancetype(namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVirtualMachineInstancetype", reflect.TypeOf((*MockClient)(nil).DeleteVirtualMachineInstancetype), namespace, name)
+}
+
+// DeleteVirtualMachinePool mocks base method.
+func (m *MockClient) DeleteVirtualMachinePool(namespace, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVirtualMachinePool", namespace, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVirtualMachinePool indicates an expected call of DeleteVirtualMachinePool.
+func (mr *MockClientMockRecorder) DeleteVirtualMachinePool(namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVirtualMachinePool", reflect.TypeOf((*MockClient)(nil).DeleteVirtualMachinePool), namespace, name)
+}
+
+// DeleteVirtualMachinePreference mocks base method.
+func (m *MockClient) DeleteVirtualMachinePreference(namespace, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVirtualMachinePreference", namespace, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVirtualMachinePreference indicates an expected call of DeleteVirtualMachinePreference.
+func (mr *MockClientMockRecorder) DeleteVirtualMachinePreference(namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVirtualMachinePreference", reflect.TypeOf((*MockClient)(nil).DeleteVirtualMachinePreference), namespace, name)
+}
+
+// DeleteVirtualMachineRestore mocks base method.
+func (m *MockClient) DeleteVirtualMachineRestore(namespace, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVirtualMachineRestore", namespace, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVirtualMachineRestore indicates an expected call of DeleteVirtualMachineRestore.
+func (mr *MockClientMockRecorder) DeleteVirtualMachineRestore(namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVirtualMachineRestore", reflect.TypeOf((*MockClient)(nil).DeleteVirtualMachineRestore), namespace, name)
+}
+
+// DeleteVirtualMachineSnapshot mocks base method.
+func (m *MockClient) DeleteVirtualMachineSnapshot(namespace, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVirtualMachineSnapshot", namespace, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVirtualMachineSnapshot indicates an expected call of DeleteVirtualMachineSnapshot.
+func (mr *MockClientMockRecorder) DeleteVirtualMachineSnapshot(namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVirtualMachineSnapshot", reflect.TypeOf((*MockClient)(nil).DeleteVirtualMachineSnapshot), namespace, name)
+}
+
+// GetCDIConfig mocks base method.
+func (m *MockClient) GetCDIConfig(name string) (*v1beta1.CDIConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCDIConfig", name)
+	ret0, _ := ret[0].(*v1beta1.CDIConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCDIConfig indicates an expected call of GetCDIConfig.
+func (mr *MockClientMockRecorder) GetCDIConfig(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCDIConfig", reflect.TypeOf((*MockClient)(nil).GetCDIConfig), name)
+}
+
+// GetDataImportCron mocks base method.
+func (m *MockClient) GetDataImportCron(namespace, name string) (*v1beta1.DataImportCron, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDataImportCron", namespace, name)
+	ret0, _ := ret[0].(*v1beta1.DataImportCron)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDataImportCron indicates an expected call of GetDataImportCron.
+func (mr *MockClientMockRecorder) GetDataImportCron(namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataImportCron", reflect.TypeOf((*MockClient)(nil).GetDataImportCron), namespace, name)
+}
+
+// GetDataSource mocks base method.
+func (m *MockClient) GetDataSource(namespace, name string) (*v1beta1.DataSource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDataSource", namespace, name)
+	ret0, _ := ret[0].(*v1beta1.DataSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDataSource indicates an expected call of GetDataSource.
+func (mr *MockClientMockRecorder) GetDataSource(namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataSource", reflect.TypeOf((*MockClient)(nil).GetDataSource), namespace, name)
+}
+
+// GetDataVolume mocks base method.
+func (m *MockClient) GetDataVolume(namespace, name string) (*v1beta1.DataVolume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDataVolume", namespace, name)
+	ret0, _ := ret[0].(*v1beta1.DataVolume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDataVolume indicates an expected call of GetDataVolume.
+func (mr *MockClientMockRecorder) GetDataVolume(namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataVolume", reflect.TypeOf((*MockClient)(nil).GetDataVolume), namespace, name)
+}
+
+// GetMigrationPolicy mocks base method.
+func (m *MockClient) GetMigrationPolicy(name string) (*v1alpha11.MigrationPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMigrationPolicy", name)
+	ret0, _ := ret[0].(*v1alpha11.MigrationPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMigrationPolicy indicates an expected call of GetMigrationPolicy.
+func (mr *MockClientMockRecorder) GetMigrationPolicy(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMigrationPolicy", reflect.TypeOf((*MockClient)(nil).GetMigrationPolicy), name)
+}
+
+// GetPersistentVolumeClaim mocks base method.
+func (m *MockClient) GetPersistentVolumeClaim(namespace, name string) (*v1.PersistentVolumeClaim, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersistentVolumeClaim", namespace, name)
+	ret0, _ := ret[0].(*v1.PersistentVolumeClaim)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersistentVolumeClaim indicates an expected call of GetPersistentVolumeClaim.
+func (mr *MockClientMockRecorder) GetPersistentVolumeClaim(namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersistentVolumeClaim", reflect.TypeOf((*MockClient)(nil).GetPersistentVolumeClaim), namespace, name)
+}
+
+// GetVirtualMachine mocks base method.
+func (m *MockClient) GetVirtualMachine(namespace, name string) (*v11.VirtualMachine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVirtualMachine", namespace, name)
+	ret0, _ := ret[0].(*v11.VirtualMachine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVirtualMachine indicates an expected call of GetVirtualMachine.
+func (mr *MockClientMockRecorder) GetVirtualMachine(namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachine", reflect.TypeOf((*MockClient)(nil).GetVirtualMachine), namespace, name)
+}
+
+// GetVirtualMachineClone mocks base method.
+func (m *MockClient) GetVirtualMachineClone(namespace, name string) (*v1alpha1.VirtualMachineClone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVirtualMachineClone", namespace, name)
+	ret0, _ := ret[0].(*v1alpha1.VirtualMachineClone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVirtualMachineClone indicates an expected call of GetVirtualMachineClone.
+func (mr *MockClientMockRecorder) GetVirtualMachineClone(namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachineClone", reflect.TypeOf((*MockClient)(nil).GetVirtualMachineClone), namespace, name)
+}
+
+// GetVirtualMachineClusterInstancetype mocks base method.
+func (m *MockClient) GetVirtualMachineClusterInstancetype(name string) (*v1alpha2.VirtualMachineClusterInstancetype, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVirtualMachineClusterInstancetype", name)
+	ret0, _ := ret[0].(*v1alpha2.VirtualMachineClusterInstancetype)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVirtualMachineClusterInstancetype indicates an expected call of GetVirtualMachineClusterInstancetype.
+func (mr *MockClientMockRecorder) GetVirtualMachineClusterInstancetype(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachineClusterInstancetype", reflect.TypeOf((*MockClient)(nil).GetVirtualMachineClusterInstancetype), name)
+}
+
+// GetVirtualMachineClusterPreference mocks base method.
+func (m *MockClient) GetVirtualMachineClusterPreference(name string) (*v1alpha2.VirtualMachineClusterPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVirtualMachineClusterPreference", name)
+	ret0, _ := ret[0].(*v1alpha2.VirtualMachineClusterPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVirtualMachineClusterPreference indicates an expected call of GetVirtualMachineClusterPreference.
+func (mr *MockClientMockRecorder) GetVirtualMachineClusterPreference(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachineClusterPreference", reflect.TypeOf((*MockClient)(nil).GetVirtualMachineClusterPreference), name)
+}
+
+// GetVirtualMachineExport mocks base method.
+func (m *MockClient) GetVirtualMachineExport(namespace, name string) (*v1alpha10.VirtualMachineExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVirtualMachineExport", namespace, name)
+	ret0, _ := ret[0].(*v1alpha10.VirtualMachineExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVirtualMachineExport indicates an expected call of GetVirtualMachineExport.
+func (mr *MockClientMockRecorder) GetVirtualMachineExport(namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachineExport", reflect.TypeOf((*MockClient)(nil).GetVirtualMachineExport), namespace, name)
+}
+
+// GetVirtualMachineInstance mocks base method.
+func (m *MockClient) GetVirtualMachineInstance(namespace, name string) (*v11.VirtualMachineInstance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVirtualMachineInstance", namespace, name)
+	ret0, _ := ret[0].(*v11.VirtualMachineInstance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVirtualMachineInstance indicates an expected call of GetVirtualMachineInstance.
+func (mr *MockClientMockRecorder) GetVirtualMachineInstance(namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachineInstance", reflect.TypeOf((*MockClient)(nil).GetVirtualMachineInstance), namespace, name)
+}
+
+// GetVirtualMachineInstanceMigration mocks base method.
+func (m *MockClient) GetVirtualMachineInstanceMigration(namespace, name string) (*v11.VirtualMachineInstanceMigration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVirtualMachineInstanceMigration", namespace, name)
+	ret0, _ := ret[0].(*v11.VirtualMachineInstanceMigration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVirtualMachineInstanceMigration indicates an expected call of GetVirtualMachineInstanceMigration.
+func (mr *MockClientMockRecorder) GetVirtualMachineInstanceMigration(namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachineInstanceMigration", reflect.TypeOf((*MockClient)(nil).GetVirtualMachineInstanceMigration), namespace, name)
+}
+
+// GetVirtualMachineInstanceReplicaSet mocks base method.
+func (m *MockClient) GetVirtualMachineInstanceReplicaSet(namespace, name string) (*v11.VirtualMachineInstanceReplicaSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVirtualMachineInstanceReplicaSet", namespace, name)
+	ret0, _ := ret[0].(*v11.VirtualMachineInstanceReplicaSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVirtualMachineInstanceReplicaSet indicates an expected call of GetVirtualMachineInstanceReplicaSet.
+func (mr *MockClientMockRecorder) GetVirtualMachineInstanceReplicaSet(namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachineInstanceReplicaSet", reflect.TypeOf((*MockClient)(nil).GetVirtualMachineInstanceReplicaSet), namespace, name)
+}
+
+// GetVirtualMachineInstancetype mocks base method.
+func (m *MockClient) GetVirtualMachineInstancetype(namespace, name string) (*v1alpha2.VirtualMachineInstancetype, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVirtualMachineInstancetype", namespace, name)
+	ret0, _ := ret[0].(*v1alpha2.VirtualMachineInstancetype)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVirtualMachineInstancetype indicates an expected call of GetVirtualMachineInstancetype.
+func (mr *MockClientMockRecorder) GetVirtualMachineInstancetype(namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachineInstancetype", reflect.TypeOf((*MockClient)(nil).GetVirtualMachineInstancetype), namespace, name)
+}
+
+// GetVirtualMachinePool mocks base method.
+func (m *MockClient) GetVirtualMachinePool(namespace, name string) (*v1alpha12.VirtualMachinePool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVirtualMachinePool", namespace, name)
+	ret0, _ := ret[0].(*v1alpha12.VirtualMachinePool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVirtualMachinePool indicates an expected call of GetVirtualMachinePool.
+func (mr *MockClientMockRecorder) GetVirtualMachinePool(namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachinePool", reflect.TypeOf((*MockClient)(nil).GetVirtualMachinePool), namespace, name)
+}
+
+// GetVirtualMachinePreference mocks base method.
+func (m *MockClient) GetVirtualMachinePreference(namespace, name string) (*v1alpha2.VirtualMachinePreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVirtualMachinePreference", namespace, name)
+	ret0, _ := ret[0].(*v1alpha2.VirtualMachinePreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVirtualMachinePreference indicates an expected call of GetVirtualMachinePreference.
+func (mr *MockClientMockRecorder) GetVirtualMachinePreference(namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachinePreference", reflect.TypeOf((*MockClient)(nil).GetVirtualMachinePreference), namespace, name)
+}
+
+// GetVirtualMachineRestore mocks base method.
+func (m *MockClient) GetVirtualMachineRestore(namespace, name string) (*v1alpha13.VirtualMachineRestore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVirtualMachineRestore", namespace, name)
+	ret0, _ := ret[0].(*v1alpha13.VirtualMachineRestore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVirtualMachineRestore indicates an expected call of GetVirtualMachineRestore.
+func (mr *MockClientMockRecorder) GetVirtualMachineRestore(namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachineRestore", reflect.TypeOf((*MockClient)(nil).GetVirtualMachineRestore), namespace, name)
+}
+
+// GetVirtualMachineSnapshot mocks base method.
+func (m *MockClient) GetVirtualMachineSnapshot(namespace, name string) (*v1alpha13.VirtualMachineSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVirtualMachineSnapshot", namespace, name)
+	ret0, _ := ret[0].(*v1alpha13.VirtualMachineSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVirtualMachineSnapshot indicates an expected call of GetVirtualMachineSnapshot.
+func (mr *MockClientMockRecorder) GetVirtualMachineSnapshot(namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachineSnapshot", reflect.TypeOf((*MockClient)(nil).GetVirtualMachineSnapshot), namespace, name)
+}
+
+// GetVirtualMachineSnapshotContent mocks base method.
+func (m *MockClient) GetVirtualMachineSnapshotContent(namespace, name string) (*v1alpha13.VirtualMachineSnapshotContent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVirtualMachineSnapshotContent", namespace, name)
+	ret0, _ := ret[0].(*v1alpha13.VirtualMachineSnapshotContent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVirtualMachineSnapshotContent indicates an expected call of GetVirtualMachineSnapshotContent.
+func (mr *MockClientMockRecorder) GetVirtualMachineSnapshotContent(namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachineSnapshotContent", reflect.TypeOf((*MockClient)(nil).GetVirtualMachineSnapshotContent), namespace, name)
+}
+
+// ListVirtualMachines mocks base method.
+func (m *MockClient) ListVirtualMachines(namespace string, options v10.ListOptions) ([]v11.VirtualMachine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVirtualMachines", namespace, options)
+	ret0, _ := ret[0].([]v11.VirtualMachine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVirtualMachines indicates an expected call of ListVirtualMachines.
+func (mr *MockClientMockRecorder) ListVirtualMachines(namespace, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualMachines", reflect.TypeOf((*MockClient)(nil).ListVirtualMachines), namespace, options)
+}
+
+// PauseVirtualMachineInstance mocks base method.
+func (m *MockClient) PauseVirtualMachineInstance(namespace, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseVirtualMachineInstance", namespace, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseVirtualMachineInstance indicates an expected call of PauseVirtualMachineInstance.
+func (mr *MockClientMockRecorder) PauseVirtualMachineInstance(namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseVirtualMachineInstance", reflect.TypeOf((*MockClient)(nil).PauseVirtualMachineInstance), namespace, name)
+}
+
+// RestartVirtualMachine mocks base method.
+func (m *MockClient) RestartVirtualMachine(namespace, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestartVirtualMachine", namespace, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestartVirtualMachine indicates an expected call of RestartVirtualMachine.
+func (mr *MockClientMockRecorder) RestartVirtualMachine(namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestartVirtualMachine", reflect.TypeOf((*MockClient)(nil).RestartVirtualMachine), namespace, name)
+}
+
+// StartVirtualMachine mocks base method.
+func (m *MockClient) StartVirtualMachine(namespace, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartVirtualMachine", namespace, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartVirtualMachine indicates an expected call of StartVirtualMachine.
+func (mr *MockClientMockRecorder) StartVirtualMachine(namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartVirtualMachine", reflect.TypeOf((*MockClient)(nil).StartVirtualMachine), namespace, name)
+}
+
+// StopVirtualMachine mocks base method.
+func (m *MockClient) StopVirtualMachine(namespace, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopVirtualMachine", namespace, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopVirtualMachine indicates an expected call of StopVirtualMachine.
+func (mr *MockClientMockRecorder) StopVirtualMachine(namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopVirtualMachine", reflect.TypeOf((*MockClient)(nil).StopVirtualMachine), namespace, name)
+}
+
+// UnpauseVirtualMachineInstance mocks base method.
+func (m *MockClient) UnpauseVirtualMachineInstance(namespace, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseVirtualMachineInstance", namespace, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseVirtualMachineInstance indicates an expected call of UnpauseVirtualMachineInstance.
+func (mr *MockClientMockRecorder) UnpauseVirtualMachineInstance(namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseVirtualMachineInstance", reflect.TypeOf((*MockClient)(nil).UnpauseVirtualMachineInstance), namespace, name)
+}
+
+// UpdateDataImportCron mocks base method.
+func (m *MockClient) UpdateDataImportCron(namespace, name string, cron *v1beta1.DataImportCron, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDataImportCron", namespace, name, cron, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDataImportCron indicates an expected call of UpdateDataImportCron.
+func (mr *MockClientMockRecorder) UpdateDataImportCron(namespace, name, cron, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDataImportCron", reflect.TypeOf((*MockClient)(nil).UpdateDataImportCron), namespace, name, cron, data)
+}
+
+// UpdateDataSource mocks base method.
+func (m *MockClient) UpdateDataSource(namespace, name string, dataSource *v1beta1.DataSource, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDataSource", namespace, name, dataSource, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDataSource indicates an expected call of UpdateDataSource.
+func (mr *MockClientMockRecorder) UpdateDataSource(namespace, name, dataSource, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDataSource", reflect.TypeOf((*MockClient)(nil).UpdateDataSource), namespace, name, dataSource, data)
+}
+
+// UpdateDataVolume mocks base method.
+func (m *MockClient) UpdateDataVolume(namespace, name string, dv *v1beta1.DataVolume, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDataVolume", namespace, name, dv, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDataVolume indicates an expected call of UpdateDataVolume.
+func (mr *MockClientMockRecorder) UpdateDataVolume(namespace, name, dv, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDataVolume", reflect.TypeOf((*MockClient)(nil).UpdateDataVolume), namespace, name, dv, data)
+}
+
+// UpdateMigrationPolicy mocks base method.
+func (m *MockClient) UpdateMigrationPolicy(name string, policy *v1alpha11.MigrationPolicy, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMigrationPolicy", name, policy, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMigrationPolicy indicates an expected call of UpdateMigrationPolicy.
+func (mr *MockClientMockRecorder) UpdateMigrationPolicy(name, policy, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMigrationPolicy", reflect.TypeOf((*MockClient)(nil).UpdateMigrationPolicy), name, policy, data)
+}
+
+// UpdatePersistentVolumeClaim mocks base method.
+func (m *MockClient) UpdatePersistentVolumeClaim(namespace, name string, pvc *v1.PersistentVolumeClaim, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePersistentVolumeClaim", namespace, name, pvc, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePersistentVolumeClaim indicates an expected call of UpdatePersistentVolumeClaim.
+func (mr *MockClientMockRecorder) UpdatePersistentVolumeClaim(namespace, name, pvc, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePersistentVolumeClaim", reflect.TypeOf((*MockClient)(nil).UpdatePersistentVolumeClaim), namespace, name, pvc, data)
+}
+
+// UpdateVirtualMachine mocks base method.
+func (m *MockClient) UpdateVirtualMachine(namespace, name string, vm *v11.VirtualMachine, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVirtualMachine", namespace, name, vm, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVirtualMachine indicates an expected call of UpdateVirtualMachine.
+func (mr *MockClientMockRecorder) UpdateVirtualMachine(namespace, name, vm, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVirtualMachine", reflect.TypeOf((*MockClient)(nil).UpdateVirtualMachine), namespace, name, vm, data)
+}
+
+// UpdateVirtualMachineClusterInstancetype mocks base method.
+func (m *MockClient) UpdateVirtualMachineClusterInstancetype(name string, clusterInstancetype *v1alpha2.VirtualMachineClusterInstancetype, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVirtualMachineClusterInstancetype", name, clusterInstancetype, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVirtualMachineClusterInstancetype indicates an expected call of UpdateVirtualMachineClusterInstancetype.
+func (mr *MockClientMockRecorder) UpdateVirtualMachineClusterInstancetype(name, clusterInstancetype, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVirtualMachineClusterInstancetype", reflect.TypeOf((*MockClient)(nil).UpdateVirtualMachineClusterInstancetype), name, clusterInstancetype, data)
+}
+
+// UpdateVirtualMachineClusterPreference mocks base method.
+func (m *MockClient) UpdateVirtualMachineClusterPreference(name string, clusterPreference *v1alpha2.VirtualMachineClusterPreference, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVirtualMachineClusterPreference", name, clusterPreference, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVirtualMachineClusterPreference indicates an expected call of UpdateVirtualMachineClusterPreference.
+func (mr *MockClientMockRecorder) UpdateVirtualMachineClusterPreference(name, clusterPreference, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVirtualMachineClusterPreference", reflect.TypeOf((*MockClient)(nil).UpdateVirtualMachineClusterPreference), name, clusterPreference, data)
+}
+
+// UpdateVirtualMachineExport mocks base method.
+func (m *MockClient) UpdateVirtualMachineExport(namespace, name string, export *v1alpha10.VirtualMachineExport, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVirtualMachineExport", namespace, name, export, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVirtualMachineExport indicates an expected call of UpdateVirtualMachineExport.
+func (mr *MockClientMockRecorder) UpdateVirtualMachineExport(namespace, name, export, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVirtualMachineExport", reflect.TypeOf((*MockClient)(nil).UpdateVirtualMachineExport), namespace, name, export, data)
+}
+
+// UpdateVirtualMachineInstance mocks base method.
+func (m *MockClient) UpdateVirtualMachineInstance(namespace, name string, vmi *v11.VirtualMachineInstance, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVirtualMachineInstance", namespace, name, vmi, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVirtualMachineInstance indicates an expected call of UpdateVirtualMachineInstance.
+func (mr *MockClientMockRecorder) UpdateVirtualMachineInstance(namespace, name, vmi, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVirtualMachineInstance", reflect.TypeOf((*MockClient)(nil).UpdateVirtualMachineInstance), namespace, name, vmi, data)
+}
+
+// UpdateVirtualMachineInstanceReplicaSet mocks base method.
+func (m *MockClient) UpdateVirtualMachineInstanceReplicaSet(namespace, name string, replicaSet *v11.VirtualMachineInstanceReplicaSet, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVirtualMachineInstanceReplicaSet", namespace, name, replicaSet, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVirtualMachineInstanceReplicaSet indicates an expected call of UpdateVirtualMachineInstanceReplicaSet.
+func (mr *MockClientMockRecorder) UpdateVirtualMachineInstanceReplicaSet(namespace, name, replicaSet, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVirtualMachineInstanceReplicaSet", reflect.TypeOf((*MockClient)(nil).UpdateVirtualMachineInstanceReplicaSet), namespace, name, replicaSet, data)
+}
+
+// UpdateVirtualMachineInstancetype mocks base method.
+func (m *MockClient) UpdateVirtualMachineInstancetype(namespace, name string, instancetype *v1alpha2.VirtualMachineInstancetype, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVirtualMachineInstancetype", namespace, name, instancetype, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVirtualMachineInstancetype indicates an expected call of UpdateVirtualMachineInstancetype.
+func (mr *MockClientMockRecorder) UpdateVirtualMachineInstancetype(namespace, name, instancetype, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVirtualMachineInstancetype", reflect.TypeOf((*MockClient)(nil).UpdateVirtualMachineInstancetype), namespace, name, instancetype, data)
+}
+
+// UpdateVirtualMachinePool mocks base method.
+func (m *MockClient) UpdateVirtualMachinePool(namespace, name string, pool *v1alpha12.VirtualMachinePool, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVirtualMachinePool", namespace, name, pool, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVirtualMachinePool indicates an expected call of UpdateVirtualMachinePool.
+func (mr *MockClientMockRecorder) UpdateVirtualMachinePool(namespace, name, pool, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVirtualMachinePool", reflect.TypeOf((*MockClient)(nil).UpdateVirtualMachinePool), namespace, name, pool, data)
+}
+
+// UpdateVirtualMachinePreference mocks base method.
+func (m *MockClient) UpdateVirtualMachinePreference(namespace, name string, preference *v1alpha2.VirtualMachinePreference, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVirtualMachinePreference", namespace, name, preference, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVirtualMachinePreference indicates an expected call of UpdateVirtualMachinePreference.
+func (mr *MockClientMockRecorder) UpdateVirtualMachinePreference(namespace, name, preference, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVirtualMachinePreference", reflect.TypeOf((*MockClient)(nil).UpdateVirtualMachinePreference), namespace, name, preference, data)
+}
+
+// UpdateVirtualMachineSnapshot mocks base method.
+func (m *MockClient) UpdateVirtualMachineSnapshot(namespace, name string, snapshot *v1alpha13.VirtualMachineSnapshot, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVirtualMachineSnapshot", namespace, name, snapshot, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVirtualMachineSnapshot indicates an expected call of UpdateVirtualMachineSnapshot.
+func (mr *MockClientMockRecorder) UpdateVirtualMachineSnapshot(namespace, name, snapshot, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVirtualMachineSnapshot", reflect.TypeOf((*MockClient)(nil).UpdateVirtualMachineSnapshot), namespace, name, snapshot, data)
 }
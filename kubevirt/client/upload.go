@@ -0,0 +1,124 @@
+package client
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// uploadPath is the endpoint of the CDI upload proxy receiving a synchronous upload.
+const uploadPath = "/v1beta1/upload"
+
+// uploadProgressStep is the percentage of the file between two progress logs.
+const uploadProgressStep = 10
+
+// NewUploadHTTPClient returns the HTTP client used to reach the upload proxy,
+// which usually serves a certificate signed by the CDI internal CA.
+func NewUploadHTTPClient(insecure bool) *http.Client {
+	if !insecure {
+		return http.DefaultClient
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	return &http.Client{Transport: transport}
+}
+
+// UploadProxyURL returns the base URL of the upload proxy, CDI reports it
+// without a scheme.
+func UploadProxyURL(url string) string {
+	url = strings.TrimSuffix(url, "/")
+	if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
+		url = "https://" + url
+	}
+	return url
+}
+
+// UploadFile streams the file at path to the upload proxy, authenticated by
+// the token of an UploadTokenRequest, and returns the SHA256 checksum of the
+// bytes it sent.
+func UploadFile(httpClient *http.Client, proxyURL string, token string, path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	body := &progressReader{
+		reader: io.TeeReader(file, hash),
+		name:   path,
+		total:  info.Size(),
+	}
+
+	req, err := http.NewRequest(http.MethodPost, UploadProxyURL(proxyURL)+uploadPath, body)
+	if err != nil {
+		return "", err
+	}
+	req.ContentLength = info.Size()
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	log.Printf("[INFO] Uploading %s (%d bytes) to %s", path, info.Size(), req.URL)
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("Failed to upload %s, with error: %v", path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return "", fmt.Errorf("Failed to upload %s, upload proxy answered %s: %s", path, resp.Status, strings.TrimSpace(string(msg)))
+	}
+
+	checksum := hex.EncodeToString(hash.Sum(nil))
+	log.Printf("[INFO] Uploaded %s, sha256=%s", path, checksum)
+	return checksum, nil
+}
+
+// FileSHA256 returns the SHA256 checksum of the file at path.
+func FileSHA256(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// progressReader logs how much of the file has been read every uploadProgressStep percent.
+type progressReader struct {
+	reader io.Reader
+	name   string
+	total  int64
+	read   int64
+	logged int64
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.read += int64(n)
+	if r.total > 0 {
+		percent := r.read * 100 / r.total
+		if percent >= r.logged+uploadProgressStep {
+			r.logged = percent - percent%uploadProgressStep
+			log.Printf("[DEBUG] Uploaded %d of %d bytes of %s (%d%%)", r.read, r.total, r.name, percent)
+		}
+	}
+	return n, err
+}
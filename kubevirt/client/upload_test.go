@@ -0,0 +1,83 @@
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func writeImage(t *testing.T, content []byte) string {
+	path := filepath.Join(t.TempDir(), "disk.qcow2")
+	assert.NilError(t, os.WriteFile(path, content, 0600))
+	return path
+}
+
+func TestUploadFile(t *testing.T) {
+	content := []byte(strings.Repeat("qcow2", 1000))
+	sum := sha256.Sum256(content)
+	checksum := hex.EncodeToString(sum[:])
+	path := writeImage(t, content)
+
+	var received []byte
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodPost)
+		assert.Equal(t, r.URL.Path, "/v1beta1/upload")
+		assert.Equal(t, r.Header.Get("Authorization"), "Bearer token")
+		assert.Equal(t, r.ContentLength, int64(len(content)))
+
+		var err error
+		received, err = io.ReadAll(r.Body)
+		assert.NilError(t, err)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	result, err := UploadFile(server.Client(), server.URL, "token", path)
+	assert.NilError(t, err)
+	assert.Equal(t, result, checksum)
+	assert.DeepEqual(t, received, content)
+
+	result, err = FileSHA256(path)
+	assert.NilError(t, err)
+	assert.Equal(t, result, checksum)
+}
+
+func TestUploadFileRejected(t *testing.T) {
+	path := writeImage(t, []byte("qcow2"))
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "invalid token", http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	_, err := UploadFile(server.Client(), server.URL, "expired", path)
+	assert.ErrorContains(t, err, "401 Unauthorized: invalid token")
+}
+
+func TestUploadFileInsecure(t *testing.T) {
+	path := writeImage(t, []byte("qcow2"))
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	_, err := UploadFile(NewUploadHTTPClient(false), server.URL, "token", path)
+	assert.ErrorContains(t, err, "certificate")
+
+	_, err = UploadFile(NewUploadHTTPClient(true), server.URL, "token", path)
+	assert.NilError(t, err)
+}
+
+func TestUploadProxyURL(t *testing.T) {
+	assert.Equal(t, UploadProxyURL("cdi-uploadproxy.example.com"), "https://cdi-uploadproxy.example.com")
+	assert.Equal(t, UploadProxyURL("http://127.0.0.1:8443/"), "http://127.0.0.1:8443")
+}
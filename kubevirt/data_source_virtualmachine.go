@@ -0,0 +1,41 @@
+package kubevirt
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/client"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/k8s"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/virtualmachine"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils"
+)
+
+func dataSourceKubevirtVirtualMachine() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceKubevirtVirtualMachineRead,
+		Schema: virtualmachine.DataSourceVirtualMachineFields(),
+	}
+}
+
+func dataSourceKubevirtVirtualMachineRead(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	metadata := k8s.ExpandMetadata(resourceData.Get("metadata").([]interface{}))
+
+	log.Printf("[INFO] Reading virtual machine %s", metadata.Name)
+
+	vm, err := cli.GetVirtualMachine(metadata.Namespace, metadata.Name)
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received virtual machine %s", vm.Name)
+
+	vmi, err := virtualMachineInstanceOf(cli, vm)
+	if err != nil {
+		return err
+	}
+
+	resourceData.SetId(utils.BuildId(vm.ObjectMeta))
+	return virtualmachine.ToResourceData(*vm, vmi, resourceData)
+}
@@ -0,0 +1,58 @@
+package kubevirt
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/client/mock"
+	"gotest.tools/assert"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sschema "k8s.io/apimachinery/pkg/runtime/schema"
+	kubevirtapiv1 "kubevirt.io/api/core/v1"
+)
+
+func TestDataSourceKubevirtVirtualMachineRead(t *testing.T) {
+	config := map[string]interface{}{
+		"metadata": []interface{}{map[string]interface{}{"name": "vm", "namespace": "default"}},
+	}
+
+	t.Run("found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		cli := mock.NewMockClient(ctrl)
+
+		vm := virtualMachineWithRunStrategy(kubevirtapiv1.RunStrategyAlways)
+		vm.Status = kubevirtapiv1.VirtualMachineStatus{Created: true, Ready: true}
+		cli.EXPECT().GetVirtualMachine("default", "vm").Return(vm, nil)
+		cli.EXPECT().GetVirtualMachineInstance("default", "vm").Return(&kubevirtapiv1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "vm", Namespace: "default"},
+			Status: kubevirtapiv1.VirtualMachineInstanceStatus{
+				Phase:      kubevirtapiv1.Running,
+				Interfaces: []kubevirtapiv1.VirtualMachineInstanceNetworkInterface{{Name: "default", IP: "10.0.0.1"}},
+			},
+		}, nil)
+
+		dataSource := dataSourceKubevirtVirtualMachine()
+		resourceData := schema.TestResourceDataRaw(t, dataSource.Schema, config)
+
+		assert.NilError(t, dataSource.Read(resourceData, cli))
+		assert.Equal(t, resourceData.Id(), "default/vm")
+		assert.Equal(t, resourceData.Get("spec.0.run_strategy").(string), "Always")
+		assert.Equal(t, resourceData.Get("status.0.ready").(bool), true)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		cli := mock.NewMockClient(ctrl)
+
+		cli.EXPECT().GetVirtualMachine("default", "vm").Return(nil, errors.NewNotFound(k8sschema.GroupResource{Group: "kubevirt.io", Resource: "virtualmachines"}, "vm"))
+
+		dataSource := dataSourceKubevirtVirtualMachine()
+		resourceData := schema.TestResourceDataRaw(t, dataSource.Schema, config)
+
+		err := dataSource.Read(resourceData, cli)
+		assert.Assert(t, errors.IsNotFound(err))
+		assert.Equal(t, resourceData.Id(), "")
+	})
+}
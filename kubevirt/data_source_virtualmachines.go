@@ -0,0 +1,54 @@
+package kubevirt
+
+import (
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/client"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/virtualmachine"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubevirtapiv1 "kubevirt.io/api/core/v1"
+)
+
+func dataSourceKubevirtVirtualMachines() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceKubevirtVirtualMachinesRead,
+		Schema: virtualmachine.DataSourceVirtualMachineListFields(),
+	}
+}
+
+func dataSourceKubevirtVirtualMachinesRead(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	namespace := resourceData.Get("namespace").(string)
+	options := metav1.ListOptions{
+		LabelSelector: resourceData.Get("label_selector").(string),
+		FieldSelector: resourceData.Get("field_selector").(string),
+	}
+
+	log.Printf("[INFO] Listing virtual machines (namespace=%q, labels=%q, fields=%q)", namespace, options.LabelSelector, options.FieldSelector)
+	vms, err := cli.ListVirtualMachines(namespace, options)
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Received %d virtual machines", len(vms))
+
+	// Only the instances of the matched virtual machines are fetched, a stopped one has none:
+	vmis := make([]kubevirtapiv1.VirtualMachineInstance, 0, len(vms))
+	for i := range vms {
+		if !vms[i].Status.Created {
+			continue
+		}
+		vmi, err := virtualMachineInstanceOf(cli, &vms[i])
+		if err != nil {
+			return err
+		}
+		if vmi != nil {
+			vmis = append(vmis, *vmi)
+		}
+	}
+
+	resourceData.SetId(strings.Join([]string{namespace, options.LabelSelector, options.FieldSelector}, "/"))
+	return resourceData.Set("items", virtualmachine.FlattenVirtualMachineList(vms, vmis))
+}
@@ -0,0 +1,45 @@
+package kubevirt
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/client/mock"
+	"gotest.tools/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubevirtapiv1 "kubevirt.io/api/core/v1"
+)
+
+func TestDataSourceKubevirtVirtualMachinesRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	cli := mock.NewMockClient(ctrl)
+
+	running := kubevirtapiv1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{Name: "running", Namespace: "default"},
+		Status:     kubevirtapiv1.VirtualMachineStatus{Created: true},
+	}
+	stopped := kubevirtapiv1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{Name: "stopped", Namespace: "default"},
+	}
+	options := metav1.ListOptions{LabelSelector: "app=web"}
+
+	cli.EXPECT().ListVirtualMachines("default", options).Return([]kubevirtapiv1.VirtualMachine{running, stopped}, nil)
+	cli.EXPECT().GetVirtualMachineInstance("default", "running").Return(&kubevirtapiv1.VirtualMachineInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "running", Namespace: "default"},
+		Status: kubevirtapiv1.VirtualMachineInstanceStatus{
+			Interfaces: []kubevirtapiv1.VirtualMachineInstanceNetworkInterface{{Name: "default", IP: "10.0.0.1"}},
+		},
+	}, nil)
+
+	dataSource := dataSourceKubevirtVirtualMachines()
+	resourceData := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{
+		"namespace":      "default",
+		"label_selector": "app=web",
+	})
+
+	assert.NilError(t, dataSource.Read(resourceData, cli))
+	assert.Equal(t, resourceData.Get("items.#").(int), 2)
+	assert.Equal(t, resourceData.Get("items.0.ip_addresses.0").(string), "10.0.0.1")
+	assert.Equal(t, resourceData.Get("items.1.ip_addresses.#").(int), 0)
+}
@@ -102,8 +102,28 @@ func Provider() *schema.Provider {
 			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"kubevirt_virtual_machine": resourceKubevirtVirtualMachine(),
-			"kubevirt_data_volume":     resourceKubevirtDataVolume(),
+			"kubevirt_virtual_machine":                      resourceKubevirtVirtualMachine(),
+			"kubevirt_virtual_machine_instance":             resourceKubevirtVirtualMachineInstance(),
+			"kubevirt_virtual_machine_instance_migration":   resourceKubevirtVirtualMachineInstanceMigration(),
+			"kubevirt_virtual_machine_instance_replica_set": resourceKubevirtVirtualMachineInstanceReplicaSet(),
+			"kubevirt_virtual_machine_restart":              resourceKubevirtVirtualMachineRestart(),
+			"kubevirt_migration_policy":                     resourceKubevirtMigrationPolicy(),
+			"kubevirt_virtual_machine_snapshot":             resourceKubevirtVirtualMachineSnapshot(),
+			"kubevirt_virtual_machine_restore":              resourceKubevirtVirtualMachineRestore(),
+			"kubevirt_virtual_machine_clone":                resourceKubevirtVirtualMachineClone(),
+			"kubevirt_virtual_machine_export":               resourceKubevirtVirtualMachineExport(),
+			"kubevirt_virtual_machine_pool":                 resourceKubevirtVirtualMachinePool(),
+			"kubevirt_virtual_machine_instancetype":         resourceKubevirtVirtualMachineInstancetype(),
+			"kubevirt_virtual_machine_cluster_instancetype": resourceKubevirtVirtualMachineClusterInstancetype(),
+			"kubevirt_virtual_machine_preference":           resourceKubevirtVirtualMachinePreference(),
+			"kubevirt_virtual_machine_cluster_preference":   resourceKubevirtVirtualMachineClusterPreference(),
+			"kubevirt_cdi_data_source":                      resourceKubevirtCdiDataSource(),
+			"kubevirt_cdi_data_import_cron":                 resourceKubevirtCdiDataImportCron(),
+			"kubevirt_data_volume":                          resourceKubevirtDataVolume(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"kubevirt_virtual_machine":  dataSourceKubevirtVirtualMachine(),
+			"kubevirt_virtual_machines": dataSourceKubevirtVirtualMachines(),
 		},
 	}
 	p.ConfigureFunc = func(resourceData *schema.ResourceData) (interface{}, error) {
@@ -0,0 +1,160 @@
+package kubevirt
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/client"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/dataimportcron"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils/patch"
+	"k8s.io/apimachinery/pkg/api/errors"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+)
+
+func resourceKubevirtCdiDataImportCron() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubevirtCdiDataImportCronCreate,
+		Read:   resourceKubevirtCdiDataImportCronRead,
+		Update: resourceKubevirtCdiDataImportCronUpdate,
+		Delete: resourceKubevirtCdiDataImportCronDelete,
+		Exists: resourceKubevirtCdiDataImportCronExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: dataimportcron.DataImportCronFields(),
+	}
+}
+
+func resourceKubevirtCdiDataImportCronCreate(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	cron, err := dataimportcron.FromResourceData(resourceData)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating new DataImportCron %s", cron.Name)
+	if err := cli.CreateDataImportCron(cron); err != nil {
+		return err
+	}
+	log.Printf("[INFO] Submitted new DataImportCron %s", cron.Name)
+
+	resourceData.SetId(utils.BuildId(cron.ObjectMeta))
+
+	return resourceKubevirtCdiDataImportCronRead(resourceData, meta)
+}
+
+func resourceKubevirtCdiDataImportCronRead(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading DataImportCron %s", name)
+
+	cron, err := cli.GetDataImportCron(namespace, name)
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received DataImportCron %s", cron.Name)
+
+	return dataimportcron.ToResourceData(*cron, resourceData)
+}
+
+func resourceKubevirtCdiDataImportCronUpdate(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	ops, err := dataimportcron.AppendPatchOps("", "", resourceData, make([]patch.PatchOperation, 0, 0))
+	if err != nil {
+		return err
+	}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("Failed to marshal update operations: %s", err)
+	}
+
+	log.Printf("[INFO] Updating DataImportCron: %s", ops)
+	out := &cdiv1.DataImportCron{}
+	if err := cli.UpdateDataImportCron(namespace, name, out, data); err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Submitted updated DataImportCron %s", out.Name)
+
+	return resourceKubevirtCdiDataImportCronRead(resourceData, meta)
+}
+
+func resourceKubevirtCdiDataImportCronDelete(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting DataImportCron: %#v", name)
+	if err := cli.DeleteDataImportCron(namespace, name); err != nil {
+		return err
+	}
+
+	// Wait for DataImportCron to be removed:
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Deleting"},
+		Timeout: resourceData.Timeout(schema.TimeoutDelete),
+		Refresh: func() (interface{}, string, error) {
+			cron, err := cli.GetDataImportCron(namespace, name)
+			if err != nil {
+				if errors.IsNotFound(err) {
+					return nil, "", nil
+				}
+				return cron, "", err
+			}
+
+			log.Printf("[DEBUG] DataImportCron %s is being deleted", cron.GetName())
+			return cron, "Deleting", nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("%s", err)
+	}
+
+	log.Printf("[INFO] DataImportCron %s deleted", name)
+
+	resourceData.SetId("")
+	return nil
+}
+
+func resourceKubevirtCdiDataImportCronExists(resourceData *schema.ResourceData, meta interface{}) (bool, error) {
+	cli := (meta).(client.Client)
+
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return false, err
+	}
+
+	log.Printf("[INFO] Checking DataImportCron %s", name)
+	if _, err := cli.GetDataImportCron(namespace, name); err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return true, err
+	}
+	return true, nil
+}
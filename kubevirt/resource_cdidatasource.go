@@ -0,0 +1,154 @@
+package kubevirt
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/client"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/datasource"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils/patch"
+	"k8s.io/apimachinery/pkg/api/errors"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+)
+
+func resourceKubevirtCdiDataSource() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubevirtCdiDataSourceCreate,
+		Read:   resourceKubevirtCdiDataSourceRead,
+		Update: resourceKubevirtCdiDataSourceUpdate,
+		Delete: resourceKubevirtCdiDataSourceDelete,
+		Exists: resourceKubevirtCdiDataSourceExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: datasource.DataSourceFields(),
+	}
+}
+
+func resourceKubevirtCdiDataSourceCreate(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	dataSource := datasource.FromResourceData(resourceData)
+
+	log.Printf("[INFO] Creating new DataSource: %#v", dataSource)
+	if err := cli.CreateDataSource(dataSource); err != nil {
+		return err
+	}
+	log.Printf("[INFO] Submitted new DataSource: %#v", dataSource)
+
+	resourceData.SetId(utils.BuildId(dataSource.ObjectMeta))
+
+	return resourceKubevirtCdiDataSourceRead(resourceData, meta)
+}
+
+func resourceKubevirtCdiDataSourceRead(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading DataSource %s", name)
+
+	dataSource, err := cli.GetDataSource(namespace, name)
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received DataSource: %#v", dataSource)
+
+	return datasource.ToResourceData(*dataSource, resourceData)
+}
+
+func resourceKubevirtCdiDataSourceUpdate(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	ops := datasource.AppendPatchOps("", "", resourceData, make([]patch.PatchOperation, 0, 0))
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("Failed to marshal update operations: %s", err)
+	}
+
+	log.Printf("[INFO] Updating DataSource: %s", ops)
+	out := &cdiv1.DataSource{}
+	if err := cli.UpdateDataSource(namespace, name, out, data); err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Submitted updated DataSource: %#v", out)
+
+	return resourceKubevirtCdiDataSourceRead(resourceData, meta)
+}
+
+func resourceKubevirtCdiDataSourceDelete(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting DataSource: %#v", name)
+	if err := cli.DeleteDataSource(namespace, name); err != nil {
+		return err
+	}
+
+	// Wait for DataSource to be removed:
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Deleting"},
+		Timeout: resourceData.Timeout(schema.TimeoutDelete),
+		Refresh: func() (interface{}, string, error) {
+			dataSource, err := cli.GetDataSource(namespace, name)
+			if err != nil {
+				if errors.IsNotFound(err) {
+					return nil, "", nil
+				}
+				return dataSource, "", err
+			}
+
+			log.Printf("[DEBUG] DataSource %s is being deleted", dataSource.GetName())
+			return dataSource, "Deleting", nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("%s", err)
+	}
+
+	log.Printf("[INFO] DataSource %s deleted", name)
+
+	resourceData.SetId("")
+	return nil
+}
+
+func resourceKubevirtCdiDataSourceExists(resourceData *schema.ResourceData, meta interface{}) (bool, error) {
+	cli := (meta).(client.Client)
+
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return false, err
+	}
+
+	log.Printf("[INFO] Checking DataSource %s", name)
+	if _, err := cli.GetDataSource(namespace, name); err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return true, err
+	}
+	return true, nil
+}
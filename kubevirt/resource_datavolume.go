@@ -1,20 +1,29 @@
 package kubevirt
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"os"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/client"
 	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/datavolume"
 	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils"
 	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils/patch"
+	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	uploadv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/upload/v1beta1"
 )
 
+// cdiConfigName is the name of the cluster wide CDIConfig.
+const cdiConfigName = "config"
+
 func resourceKubevirtDataVolume() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceKubevirtDataVolumeCreate,
@@ -29,6 +38,11 @@ func resourceKubevirtDataVolume() *schema.Resource {
 			Create: schema.DefaultTimeout(40 * time.Minute),
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
+		CustomizeDiff: customdiff.All(
+			customdiff.ForceNewIfChange(datavolume.StorageRequestKeys()[0], datavolume.StorageRequestDecreased),
+			customdiff.ForceNewIfChange(datavolume.StorageRequestKeys()[1], datavolume.StorageRequestDecreased),
+			resourceKubevirtDataVolumeUploadDiff,
+		),
 		Schema: datavolume.DataVolumeFields(),
 	}
 }
@@ -41,24 +55,51 @@ func resourceKubevirtDataVolumeCreate(resourceData *schema.ResourceData, meta in
 		return err
 	}
 
-	log.Printf("[INFO] Creating new data volume: %#v", dv)
+	log.Printf("[INFO] Creating new data volume %s", dv.Name)
 	if err := cli.CreateDataVolume(dv); err != nil {
 		return err
 	}
-	log.Printf("[INFO] Submitted new data volume: %#v", dv)
+	log.Printf("[INFO] Submitted new data volume %s", dv.Name)
 	if err := datavolume.ToResourceData(*dv, resourceData); err != nil {
 		return err
 	}
 	resourceData.SetId(utils.BuildId(dv.ObjectMeta))
 
-	// Wait for data volume instance's status phase to be succeeded:
 	name := dv.ObjectMeta.Name
 	namespace := dv.ObjectMeta.Namespace
 
+	// A data volume with an upload source waits for the image to be sent to the upload proxy:
+	if dv.Spec.Source != nil && dv.Spec.Source.Upload != nil {
+		dv, err = waitForDataVolumePhase(cli, namespace, name, cdiv1.UploadReady, resourceData.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return err
+		}
+
+		upload := datavolume.ExpandUpload(resourceData.Get("upload").([]interface{}))
+		if upload == nil {
+			log.Printf("[INFO] data volume %s is ready for an upload", name)
+			return datavolume.ToResourceData(*dv, resourceData)
+		}
+		if err := uploadDataVolume(cli, namespace, name, upload, resourceData); err != nil {
+			return err
+		}
+	}
+
+	// Wait for data volume instance's status phase to be succeeded:
+	dv, err = waitForDataVolumePhase(cli, namespace, name, cdiv1.Succeeded, resourceData.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+	return datavolume.ToResourceData(*dv, resourceData)
+}
+
+func waitForDataVolumePhase(cli client.Client, namespace string, name string, phase cdiv1.DataVolumePhase, timeout time.Duration) (*cdiv1.DataVolume, error) {
+	var dv *cdiv1.DataVolume
+
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"Creating"},
-		Target:  []string{"Succeeded"},
-		Timeout: resourceData.Timeout(schema.TimeoutCreate),
+		Target:  []string{string(phase)},
+		Timeout: timeout,
 		Refresh: func() (interface{}, string, error) {
 			var err error
 			dv, err = cli.GetDataVolume(namespace, name)
@@ -71,8 +112,8 @@ func resourceKubevirtDataVolumeCreate(resourceData *schema.ResourceData, meta in
 			}
 
 			switch dv.Status.Phase {
-			case cdiv1.Succeeded:
-				return dv, "Succeeded", nil
+			case phase:
+				return dv, string(phase), nil
 			case cdiv1.Failed:
 				return dv, "", fmt.Errorf("data volume failed to be created, finished with phase=\"failed\"")
 			}
@@ -83,9 +124,94 @@ func resourceKubevirtDataVolumeCreate(resourceData *schema.ResourceData, meta in
 	}
 
 	if _, err := stateConf.WaitForState(); err != nil {
-		return fmt.Errorf("%s", err)
+		return nil, fmt.Errorf("%s", err)
 	}
-	return datavolume.ToResourceData(*dv, resourceData)
+	return dv, nil
+}
+
+// uploadDataVolume streams the local disk image to the upload proxy, once the
+// data volume is ready to receive it, and records the checksum of what was sent.
+func uploadDataVolume(cli client.Client, namespace string, name string, upload *datavolume.Upload, resourceData *schema.ResourceData) error {
+	proxyURL := upload.ProxyURL
+	if proxyURL == "" {
+		config, err := cli.GetCDIConfig(cdiConfigName)
+		if err != nil {
+			return err
+		}
+		if config.Status.UploadProxyURL == nil || *config.Status.UploadProxyURL == "" {
+			return fmt.Errorf("CDI doesn't report the URL of its upload proxy, it has to be set in upload.0.proxy_url")
+		}
+		proxyURL = *config.Status.UploadProxyURL
+	}
+
+	tokenRequest := &uploadv1beta1.UploadTokenRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: uploadv1beta1.UploadTokenRequestSpec{
+			PvcName: name,
+		},
+	}
+	log.Printf("[INFO] Requesting upload token for data volume %s", name)
+	if err := cli.CreateUploadTokenRequest(tokenRequest); err != nil {
+		return err
+	}
+	if tokenRequest.Status.Token == "" {
+		return fmt.Errorf("CDI returned no upload token for data volume %s", name)
+	}
+
+	checksum, err := client.UploadFile(client.NewUploadHTTPClient(upload.Insecure), proxyURL, tokenRequest.Status.Token, upload.Path)
+	if err != nil {
+		return err
+	}
+	if planned := resourceData.Get("upload_sha256").(string); planned != "" && planned != checksum {
+		return fmt.Errorf("%s changed since the plan, its sha256 is %s instead of %s", upload.Path, checksum, planned)
+	}
+	return resourceData.Set("upload_sha256", checksum)
+}
+
+// resourceKubevirtDataVolumeUploadDiff records the checksum of the disk image
+// to upload, the data volume is recreated to upload it again when it changes.
+func resourceKubevirtDataVolumeUploadDiff(ctx context.Context, resourceDiff *schema.ResourceDiff, meta interface{}) error {
+	upload := datavolume.ExpandUpload(resourceDiff.Get("upload").([]interface{}))
+	if upload == nil {
+		return nil
+	}
+	if !resourceDiff.Get("spec.0.source.0.upload").(bool) {
+		return fmt.Errorf("upload requires the source of the data volume to be upload")
+	}
+
+	if !resourceDiff.NewValueKnown("upload.0.path") {
+		if err := resourceDiff.SetNewComputed("upload_sha256"); err != nil {
+			return err
+		}
+	} else {
+		uploaded := resourceDiff.Get("upload_sha256").(string)
+		checksum, err := client.FileSHA256(upload.Path)
+		if err != nil {
+			// The image is only needed to upload it again, one already uploaded is kept when
+			// planning from where it isn't available, such as another machine or a fresh workspace.
+			if os.IsNotExist(err) && uploaded != "" {
+				log.Printf("[WARN] %s doesn't exist, keeping the image uploaded to data volume %s", upload.Path, resourceDiff.Id())
+				return nil
+			}
+			return fmt.Errorf("Failed to compute the checksum of %s: %s", upload.Path, err)
+		}
+		if checksum == uploaded {
+			return nil
+		}
+		if err := resourceDiff.SetNew("upload_sha256", checksum); err != nil {
+			return err
+		}
+	}
+
+	// Only an image uploaded by the provider is uploaded again, one uploaded by other means,
+	// such as a data volume imported or created before the upload block was set, is kept.
+	if old, _ := resourceDiff.GetChange("upload_sha256"); resourceDiff.Id() == "" || old.(string) == "" {
+		return nil
+	}
+	return resourceDiff.ForceNew("upload_sha256")
 }
 
 func resourceKubevirtDataVolumeRead(resourceData *schema.ResourceData, meta interface{}) error {
@@ -103,7 +229,17 @@ func resourceKubevirtDataVolumeRead(resourceData *schema.ResourceData, meta inte
 		log.Printf("[DEBUG] Received error: %#v", err)
 		return err
 	}
-	log.Printf("[INFO] Received data volume: %#v", dv)
+	log.Printf("[INFO] Received data volume %s", dv.Name)
+
+	pvc, err := cli.GetPersistentVolumeClaim(namespace, name)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		log.Printf("[DEBUG] data volume %s has no persistent volume claim yet", name)
+	} else {
+		datavolume.SyncClaimStorageRequest(dv, pvc, resourceData)
+	}
 
 	return datavolume.ToResourceData(*dv, resourceData)
 }
@@ -128,7 +264,22 @@ func resourceKubevirtDataVolumeUpdate(resourceData *schema.ResourceData, meta in
 		return err
 	}
 
-	log.Printf("[INFO] Submitted updated data volume: %#v", out)
+	log.Printf("[INFO] Submitted updated data volume %s", out.Name)
+
+	claimOps := datavolume.AppendClaimPatchOps("", "", resourceData, make([]patch.PatchOperation, 0, 0))
+	if len(claimOps) > 0 {
+		data, err := claimOps.MarshalJSON()
+		if err != nil {
+			return fmt.Errorf("Failed to marshal update operations: %s", err)
+		}
+
+		log.Printf("[INFO] Expanding persistent volume claim of data volume %s: %s", name, claimOps)
+		pvc := &k8sv1.PersistentVolumeClaim{}
+		if err := cli.UpdatePersistentVolumeClaim(namespace, name, pvc, data); err != nil {
+			return err
+		}
+		log.Printf("[INFO] Submitted updated persistent volume claim: %#v", pvc)
+	}
 
 	return resourceKubevirtDataVolumeRead(resourceData, meta)
 }
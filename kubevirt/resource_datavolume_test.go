@@ -0,0 +1,134 @@
+package kubevirt
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/client/mock"
+	"gotest.tools/assert"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sschema "k8s.io/apimachinery/pkg/runtime/schema"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+)
+
+func TestResourceKubevirtDataVolumeUploadDiff(t *testing.T) {
+	image := filepath.Join(t.TempDir(), "disk.img")
+	assert.NilError(t, os.WriteFile(image, []byte("disk image"), 0600))
+	// sha256sum of "disk image"
+	checksum := "0bb2f0f3ed953c47d835a7adaefd95afa328e30a5c80fdce417dd12b014ad602"
+
+	spec := []interface{}{map[string]interface{}{
+		"source": []interface{}{map[string]interface{}{"upload": true}},
+		"storage": []interface{}{map[string]interface{}{
+			"resources": []interface{}{map[string]interface{}{
+				"requests": map[string]interface{}{"storage": "10Gi"},
+			}},
+		}},
+	}}
+
+	missing := filepath.Join(t.TempDir(), "missing.img")
+
+	cases := []struct {
+		name        string
+		path        string
+		uploaded    string
+		requiresNew bool
+		expectError bool
+	}{
+		{
+			name:        "image changed",
+			path:        image,
+			uploaded:    "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+			requiresNew: true,
+		},
+		{
+			name:     "image unchanged",
+			path:     image,
+			uploaded: checksum,
+		},
+		{
+			name:     "image not uploaded by the provider",
+			path:     image,
+			uploaded: "",
+		},
+		{
+			name:     "uploaded image not available",
+			path:     missing,
+			uploaded: checksum,
+		},
+		{
+			name:        "image to upload not available",
+			path:        missing,
+			uploaded:    "",
+			expectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := resourceKubevirtDataVolume()
+
+			resourceData := r.TestResourceData()
+			assert.NilError(t, resourceData.Set("metadata", []interface{}{map[string]interface{}{"name": "dv", "namespace": "default"}}))
+			assert.NilError(t, resourceData.Set("spec", spec))
+			assert.NilError(t, resourceData.Set("upload_sha256", tc.uploaded))
+			resourceData.SetId("default/dv")
+
+			diff, err := r.Diff(context.Background(), resourceData.State(), terraform.NewResourceConfigRaw(map[string]interface{}{
+				"metadata": []interface{}{map[string]interface{}{"name": "dv", "namespace": "default"}},
+				"spec":     spec,
+				"upload":   []interface{}{map[string]interface{}{"path": tc.path}},
+			}), nil)
+			if tc.expectError {
+				assert.ErrorContains(t, err, "Failed to compute the checksum")
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, diff.RequiresNew(), tc.requiresNew)
+			if tc.path == image && tc.uploaded != checksum {
+				assert.Equal(t, diff.Attributes["upload_sha256"].New, checksum)
+			}
+		})
+	}
+}
+
+func TestResourceKubevirtDataVolumeLogsNoSecretExtraHeaders(t *testing.T) {
+	var logs bytes.Buffer
+	log.SetOutput(&logs)
+	t.Cleanup(func() { log.SetOutput(os.Stderr) })
+
+	dv := &cdiv1.DataVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "dv", Namespace: "default"},
+		Spec: cdiv1.DataVolumeSpec{
+			Source: &cdiv1.DataVolumeSource{
+				HTTP: &cdiv1.DataVolumeSourceHTTP{
+					URL:                "https://example.com/disk.img",
+					SecretExtraHeaders: []string{"registry-bearer-token"},
+				},
+			},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	cli := mock.NewMockClient(ctrl)
+
+	cli.EXPECT().GetDataVolume("default", "dv").Return(dv, nil)
+	cli.EXPECT().GetPersistentVolumeClaim("default", "dv").Return(nil, errors.NewNotFound(k8sschema.GroupResource{Resource: "persistentvolumeclaims"}, "dv"))
+
+	resourceData := resourceKubevirtDataVolume().TestResourceData()
+	resourceData.SetId("default/dv")
+
+	assert.NilError(t, resourceKubevirtDataVolumeRead(resourceData, cli))
+	assert.Equal(t, resourceData.Get("spec.0.source.0.http.0.secret_extra_headers.0"), "registry-bearer-token")
+
+	assert.Assert(t, logs.Len() > 0)
+	assert.Assert(t, !strings.Contains(logs.String(), "registry-bearer-token"), logs.String())
+}
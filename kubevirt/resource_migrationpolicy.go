@@ -0,0 +1,148 @@
+package kubevirt
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/client"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/migrationpolicy"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils/patch"
+	"k8s.io/apimachinery/pkg/api/errors"
+	migrationsv1alpha1 "kubevirt.io/api/migrations/v1alpha1"
+)
+
+func resourceKubevirtMigrationPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubevirtMigrationPolicyCreate,
+		Read:   resourceKubevirtMigrationPolicyRead,
+		Update: resourceKubevirtMigrationPolicyUpdate,
+		Delete: resourceKubevirtMigrationPolicyDelete,
+		Exists: resourceKubevirtMigrationPolicyExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: migrationpolicy.MigrationPolicyFields(),
+	}
+}
+
+func resourceKubevirtMigrationPolicyCreate(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	policy, err := migrationpolicy.FromResourceData(resourceData)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating new migration policy: %#v", policy)
+	if err := cli.CreateMigrationPolicy(policy); err != nil {
+		return err
+	}
+	log.Printf("[INFO] Submitted new migration policy: %#v", policy)
+
+	// Migration policies are cluster-scoped, their name is enough to identify them.
+	resourceData.SetId(policy.Name)
+
+	return resourceKubevirtMigrationPolicyRead(resourceData, meta)
+}
+
+func resourceKubevirtMigrationPolicyRead(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	name := resourceData.Id()
+
+	log.Printf("[INFO] Reading migration policy %s", name)
+
+	policy, err := cli.GetMigrationPolicy(name)
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received migration policy: %#v", policy)
+
+	return migrationpolicy.ToResourceData(*policy, resourceData)
+}
+
+func resourceKubevirtMigrationPolicyUpdate(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	name := resourceData.Id()
+
+	ops, err := migrationpolicy.AppendPatchOps("", "", resourceData, make([]patch.PatchOperation, 0, 0))
+	if err != nil {
+		return err
+	}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("Failed to marshal update operations: %s", err)
+	}
+
+	log.Printf("[INFO] Updating migration policy: %s", ops)
+	out := &migrationsv1alpha1.MigrationPolicy{}
+	if err := cli.UpdateMigrationPolicy(name, out, data); err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Submitted updated migration policy: %#v", out)
+
+	return resourceKubevirtMigrationPolicyRead(resourceData, meta)
+}
+
+func resourceKubevirtMigrationPolicyDelete(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	name := resourceData.Id()
+
+	log.Printf("[INFO] Deleting migration policy: %#v", name)
+	if err := cli.DeleteMigrationPolicy(name); err != nil {
+		return err
+	}
+
+	// Wait for migration policy to be removed:
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Deleting"},
+		Timeout: resourceData.Timeout(schema.TimeoutDelete),
+		Refresh: func() (interface{}, string, error) {
+			policy, err := cli.GetMigrationPolicy(name)
+			if err != nil {
+				if errors.IsNotFound(err) {
+					return nil, "", nil
+				}
+				return policy, "", err
+			}
+
+			log.Printf("[DEBUG] Migration policy %s is being deleted", policy.GetName())
+			return policy, "Deleting", nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("%s", err)
+	}
+
+	log.Printf("[INFO] migration policy %s deleted", name)
+
+	resourceData.SetId("")
+	return nil
+}
+
+func resourceKubevirtMigrationPolicyExists(resourceData *schema.ResourceData, meta interface{}) (bool, error) {
+	cli := (meta).(client.Client)
+
+	name := resourceData.Id()
+
+	log.Printf("[INFO] Checking migration policy %s", name)
+	if _, err := cli.GetMigrationPolicy(name); err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return true, err
+	}
+	return true, nil
+}
@@ -1,32 +1,42 @@
 package kubevirt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/client"
 	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/virtualmachine"
 	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils"
 	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils/patch"
+	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	kubevirtapiv1 "kubevirt.io/api/core/v1"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 )
 
 func resourceKubevirtVirtualMachine() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceKubevirtVirtualMachineCreate,
-		Read:   resourceKubevirtVirtualMachineRead,
-		Update: resourceKubevirtVirtualMachineUpdate,
-		Delete: resourceKubevirtVirtualMachineDelete,
-		Exists: resourceKubevirtVirtualMachineExists,
+		Create:      resourceKubevirtVirtualMachineCreate,
+		ReadContext: resourceKubevirtVirtualMachineRead,
+		Update:      resourceKubevirtVirtualMachineUpdate,
+		Delete:      resourceKubevirtVirtualMachineDelete,
+		Exists:      resourceKubevirtVirtualMachineExists,
+		CustomizeDiff: customdiff.All(
+			virtualMachineWaitForCustomizeDiff,
+			virtualMachinePowerStateCustomizeDiff,
+		),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(40 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 		Schema: virtualmachine.VirtualMachineFields(),
@@ -41,20 +51,36 @@ func resourceKubevirtVirtualMachineCreate(resourceData *schema.ResourceData, met
 		return err
 	}
 
-	log.Printf("[INFO] Creating new virtual machine: %#v", vm)
+	log.Printf("[INFO] Creating new virtual machine %s", vm.Name)
 	if err := cli.CreateVirtualMachine(vm); err != nil {
 		return err
 	}
-	log.Printf("[INFO] Submitted new virtual machine: %#v", vm)
-	if err := virtualmachine.ToResourceData(*vm, resourceData); err != nil {
+	log.Printf("[INFO] Submitted new virtual machine %s", vm.Name)
+	if err := virtualmachine.ToResourceData(*vm, nil, resourceData); err != nil {
 		return err
 	}
 	resourceData.SetId(utils.BuildId(vm.ObjectMeta))
 
+	// Bring the virtual machine to its power state first, a stopped one never reaches the
+	// conditions of its instance.
+	if err := resourceKubevirtVirtualMachineCreatePowerState(resourceData, meta); err != nil {
+		return err
+	}
+
 	// Wait for virtual machine instance's status phase to be succeeded:
 	name := vm.ObjectMeta.Name
 	namespace := vm.ObjectMeta.Namespace
 
+	waitFor, err := virtualMachineWaitFor(vm, resourceData)
+	if err != nil {
+		return err
+	}
+	if waitFor.Condition == virtualmachine.WaitForNone {
+		log.Printf("[INFO] Not waiting for virtual machine %s to be created", name)
+		_, err = readVirtualMachine(resourceData, meta)
+		return err
+	}
+
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"Creating"},
 		Target:  []string{"Succeeded"},
@@ -70,8 +96,34 @@ func resourceKubevirtVirtualMachineCreate(resourceData *schema.ResourceData, met
 				return vm, "", err
 			}
 
-			if vm.Status.Created == true && vm.Status.Ready == true {
-				return vm, "Succeeded", nil
+			switch waitFor.Condition {
+			case waitForProvisioned:
+				provisioned, err := virtualMachineDataVolumesProvisioned(cli, vm)
+				if err != nil {
+					return vm, "", err
+				}
+				if provisioned {
+					return vm, "Succeeded", nil
+				}
+			case virtualmachine.WaitForReady:
+				if vm.Status.Created == true && vm.Status.Ready == true {
+					return vm, "Succeeded", nil
+				}
+			default:
+				vmi, err := cli.GetVirtualMachineInstance(namespace, name)
+				if err != nil {
+					if errors.IsNotFound(err) {
+						log.Printf("[DEBUG] virtual machine instance %s is not created yet", name)
+						return vm, "Creating", nil
+					}
+					return vm, "", err
+				}
+				if vmi.Status.Phase == kubevirtapiv1.Failed {
+					return vm, "", fmt.Errorf("virtual machine instance %s failed to start, finished with phase=\"failed\"", name)
+				}
+				if virtualMachineInstanceReached(vmi, waitFor) {
+					return vm, "Succeeded", nil
+				}
 			}
 
 			log.Printf("[DEBUG] virtual machine %s is being created", name)
@@ -83,15 +135,111 @@ func resourceKubevirtVirtualMachineCreate(resourceData *schema.ResourceData, met
 		return fmt.Errorf("%s", err)
 	}
 
-	return resourceKubevirtVirtualMachineRead(resourceData, meta)
+	_, err = readVirtualMachine(resourceData, meta)
+	return err
+}
+
+// resourceKubevirtVirtualMachineCreatePowerState brings the new virtual machine to its power state, if any.
+func resourceKubevirtVirtualMachineCreatePowerState(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	if powerState := resourceData.Get("power_state").(string); powerState != "" {
+		namespace, name, err := utils.IdParts(resourceData.Id())
+		if err != nil {
+			return err
+		}
+		return setVirtualMachinePowerState(cli, namespace, name, powerState, resourceData.Timeout(schema.TimeoutCreate))
+	}
+	return nil
+}
+
+// waitForProvisioned is what the creation of a stopped virtual machine waits for
+// when no wait_for block is set, since such virtual machines never become ready.
+const waitForProvisioned = "Provisioned"
+
+func virtualMachineWaitFor(vm *kubevirtapiv1.VirtualMachine, resourceData *schema.ResourceData) (*virtualmachine.WaitFor, error) {
+	if waitFor := virtualmachine.ExpandWaitFor(resourceData.Get("wait_for").([]interface{})); waitFor != nil {
+		return waitFor, nil
+	}
+
+	runStrategy, err := vm.RunStrategy()
+	if err != nil {
+		return nil, err
+	}
+	switch runStrategy {
+	case kubevirtapiv1.RunStrategyHalted, kubevirtapiv1.RunStrategyManual:
+		return &virtualmachine.WaitFor{Condition: waitForProvisioned}, nil
+	}
+	return &virtualmachine.WaitFor{Condition: virtualmachine.WaitForReady}, nil
+}
+
+// virtualMachineInstanceReached reports whether the virtual machine instance reached the awaited condition.
+func virtualMachineInstanceReached(vmi *kubevirtapiv1.VirtualMachineInstance, waitFor *virtualmachine.WaitFor) bool {
+	switch waitFor.Condition {
+	case virtualmachine.WaitForRunning:
+		return vmi.Status.Phase == kubevirtapiv1.Running
+	case virtualmachine.WaitForAgentConnected:
+		for _, condition := range vmi.Status.Conditions {
+			if condition.Type == kubevirtapiv1.VirtualMachineInstanceAgentConnected {
+				return condition.Status == k8sv1.ConditionTrue
+			}
+		}
+	case virtualmachine.WaitForIPAddress:
+		for _, iface := range vmi.Status.Interfaces {
+			if waitFor.Interface != "" && iface.Name != waitFor.Interface {
+				continue
+			}
+			if iface.IP != "" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// virtualMachineDataVolumesProvisioned reports whether every data volume templated by the
+// virtual machine is populated, or waits for a consumer to be scheduled before populating.
+func virtualMachineDataVolumesProvisioned(cli client.Client, vm *kubevirtapiv1.VirtualMachine) (bool, error) {
+	for _, template := range vm.Spec.DataVolumeTemplates {
+		dv, err := cli.GetDataVolume(vm.Namespace, template.Name)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				log.Printf("[DEBUG] data volume %s is not created yet", template.Name)
+				return false, nil
+			}
+			return false, err
+		}
+
+		switch dv.Status.Phase {
+		case cdiv1.Succeeded, cdiv1.WaitForFirstConsumer:
+			continue
+		case cdiv1.Failed:
+			return false, fmt.Errorf("data volume %s failed to be provisioned, finished with phase=\"failed\"", dv.Name)
+		}
+
+		log.Printf("[DEBUG] data volume %s is being provisioned", dv.Name)
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func resourceKubevirtVirtualMachineRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vmi, err := readVirtualMachine(resourceData, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return liveMigratabilityDiagnostics(vmi)
 }
 
-func resourceKubevirtVirtualMachineRead(resourceData *schema.ResourceData, meta interface{}) error {
+// readVirtualMachine refreshes the state from the virtual machine and returns its running instance,
+// nil when it is not running.
+func readVirtualMachine(resourceData *schema.ResourceData, meta interface{}) (*kubevirtapiv1.VirtualMachineInstance, error) {
 	cli := (meta).(client.Client)
 
 	namespace, name, err := utils.IdParts(resourceData.Id())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	log.Printf("[INFO] Reading virtual machine %s", name)
@@ -99,11 +247,30 @@ func resourceKubevirtVirtualMachineRead(resourceData *schema.ResourceData, meta
 	vm, err := cli.GetVirtualMachine(namespace, name)
 	if err != nil {
 		log.Printf("[DEBUG] Received error: %#v", err)
-		return err
+		return nil, err
+	}
+	log.Printf("[INFO] Received virtual machine %s", vm.Name)
+
+	vmi, err := virtualMachineInstanceOf(cli, vm)
+	if err != nil {
+		return nil, err
 	}
-	log.Printf("[INFO] Received virtual machine: %#v", vm)
 
-	return virtualmachine.ToResourceData(*vm, resourceData)
+	return vmi, virtualmachine.ToResourceData(*vm, vmi, resourceData)
+}
+
+// virtualMachineInstanceOf returns the running instance of the virtual machine, nil when it is not running.
+func virtualMachineInstanceOf(cli client.Client, vm *kubevirtapiv1.VirtualMachine) (*kubevirtapiv1.VirtualMachineInstance, error) {
+	vmi, err := cli.GetVirtualMachineInstance(vm.Namespace, vm.Name)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Printf("[DEBUG] virtual machine %s has no running instance", vm.Name)
+			return nil, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return nil, err
+	}
+	return vmi, nil
 }
 
 func resourceKubevirtVirtualMachineUpdate(resourceData *schema.ResourceData, meta interface{}) error {
@@ -114,7 +281,10 @@ func resourceKubevirtVirtualMachineUpdate(resourceData *schema.ResourceData, met
 		return err
 	}
 
-	ops := virtualmachine.AppendPatchOps("", "", resourceData, make([]patch.PatchOperation, 0, 0))
+	ops, err := virtualmachine.AppendPatchOps("", "", resourceData, make([]patch.PatchOperation, 0, 0))
+	if err != nil {
+		return err
+	}
 	data, err := ops.MarshalJSON()
 	if err != nil {
 		return fmt.Errorf("Failed to marshal update operations: %s", err)
@@ -126,9 +296,141 @@ func resourceKubevirtVirtualMachineUpdate(resourceData *schema.ResourceData, met
 		return err
 	}
 
-	log.Printf("[INFO] Submitted updated virtual machine: %#v", out)
+	log.Printf("[INFO] Submitted updated virtual machine %s", out.Name)
+
+	if resourceData.HasChange("power_state") {
+		if powerState := resourceData.Get("power_state").(string); powerState != "" {
+			if err := setVirtualMachinePowerState(cli, namespace, name, powerState, resourceData.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err = readVirtualMachine(resourceData, meta)
+	return err
+}
+
+// virtualMachineWaitForCustomizeDiff rejects creating a virtual machine which waits for a condition
+// it never reaches, stopped virtual machines have no instance to wait for.
+func virtualMachineWaitForCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" || !diff.NewValueKnown("spec.0.run_strategy") {
+		return nil
+	}
+
+	// power_state is computed, only the configured one tells what the new virtual machine is brought to.
+	var powerState string
+	if config := diff.GetRawConfig(); !config.IsNull() && config.IsKnown() {
+		configured := config.GetAttr("power_state")
+		if !configured.IsKnown() {
+			return nil
+		}
+		if !configured.IsNull() {
+			powerState = configured.AsString()
+		}
+	}
+
+	runStrategy := kubevirtapiv1.VirtualMachineRunStrategy(diff.Get("spec.0.run_strategy").(string))
+	return virtualMachineWaitForReachable(runStrategy, powerState, virtualmachine.ExpandWaitFor(diff.Get("wait_for").([]interface{})))
+}
+
+// virtualMachineWaitForReachable tells whether the creation of a virtual machine with the run strategy,
+// brought to the power state if any, can reach the wait_for condition.
+func virtualMachineWaitForReachable(runStrategy kubevirtapiv1.VirtualMachineRunStrategy, powerState string, waitFor *virtualmachine.WaitFor) error {
+	if waitFor == nil || waitFor.Condition == virtualmachine.WaitForNone {
+		return nil
+	}
+
+	if powerState == virtualmachine.PowerStateStopped {
+		return fmt.Errorf("wait_for %q is never reached by a virtual machine created with power_state %q", waitFor.Condition, powerState)
+	}
+	if powerState == "" && (runStrategy == kubevirtapiv1.RunStrategyHalted || runStrategy == kubevirtapiv1.RunStrategyManual) {
+		return fmt.Errorf("wait_for %q is never reached by a virtual machine created with run strategy %q and no power_state", waitFor.Condition, runStrategy)
+	}
+	return nil
+}
+
+// virtualMachinePowerStateCustomizeDiff rejects a power_state on virtual machines whose run strategy
+// would fight it: start and stop only keep the run strategy untouched when it is "Manual".
+func virtualMachinePowerStateCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	config := diff.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() || config.GetAttr("power_state").IsNull() {
+		return nil
+	}
+
+	if runStrategy := diff.Get("spec.0.run_strategy").(string); runStrategy != string(kubevirtapiv1.RunStrategyManual) {
+		return fmt.Errorf("power_state requires the %q run strategy, got %q", kubevirtapiv1.RunStrategyManual, runStrategy)
+	}
+	return nil
+}
 
-	return resourceKubevirtVirtualMachineRead(resourceData, meta)
+// setVirtualMachinePowerState drives the virtual machine to the power state through the start, stop,
+// pause and unpause subresources, then waits for its printable status to match.
+func setVirtualMachinePowerState(cli client.Client, namespace, name, powerState string, timeout time.Duration) error {
+	vm, err := cli.GetVirtualMachine(namespace, name)
+	if err != nil {
+		return err
+	}
+
+	// Created tells whether an instance exists, it is the reliable signal while the printable status is transitioning.
+	created := vm.Status.Created
+	paused := vm.Status.PrintableStatus == kubevirtapiv1.VirtualMachineStatusPaused
+
+	log.Printf("[INFO] Changing power state of virtual machine %s from %q to %q", name, vm.Status.PrintableStatus, powerState)
+	switch powerState {
+	case virtualmachine.PowerStateRunning:
+		if !created {
+			err = cli.StartVirtualMachine(namespace, name)
+		} else if paused {
+			err = cli.UnpauseVirtualMachineInstance(namespace, name)
+		}
+	case virtualmachine.PowerStateStopped:
+		if created {
+			err = cli.StopVirtualMachine(namespace, name)
+		}
+	case virtualmachine.PowerStatePaused:
+		if !created {
+			if err := cli.StartVirtualMachine(namespace, name); err != nil {
+				return err
+			}
+		}
+		if !paused {
+			if err := waitForVirtualMachinePowerState(cli, namespace, name, virtualmachine.PowerStateRunning, timeout); err != nil {
+				return err
+			}
+			err = cli.PauseVirtualMachineInstance(namespace, name)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	return waitForVirtualMachinePowerState(cli, namespace, name, powerState, timeout)
+}
+
+func waitForVirtualMachinePowerState(cli client.Client, namespace, name, powerState string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Changing"},
+		Target:  []string{powerState},
+		Timeout: timeout,
+		Refresh: func() (interface{}, string, error) {
+			vm, err := cli.GetVirtualMachine(namespace, name)
+			if err != nil {
+				return vm, "", err
+			}
+
+			if current := virtualmachine.FlattenPowerState(vm.Status.PrintableStatus); current == powerState {
+				return vm, current, nil
+			}
+
+			log.Printf("[DEBUG] virtual machine %s is %s, waiting for %s", name, vm.Status.PrintableStatus, powerState)
+			return vm, "Changing", nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("%s", err)
+	}
+	return nil
 }
 
 func resourceKubevirtVirtualMachineDelete(resourceData *schema.ResourceData, meta interface{}) error {
@@ -0,0 +1,154 @@
+package kubevirt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/client"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/virtualmachine"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils"
+	"k8s.io/apimachinery/pkg/api/errors"
+)
+
+func resourceKubevirtVirtualMachineRestart() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceKubevirtVirtualMachineRestartCreate,
+		Read:          resourceKubevirtVirtualMachineRestartRead,
+		Update:        resourceKubevirtVirtualMachineRestartUpdate,
+		Delete:        resourceKubevirtVirtualMachineRestartDelete,
+		CustomizeDiff: virtualMachineRestartCustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: virtualmachine.VirtualMachineRestartFields(),
+	}
+}
+
+func resourceKubevirtVirtualMachineRestartCreate(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	namespace := resourceData.Get("namespace").(string)
+	name := resourceData.Get("name").(string)
+
+	vm, err := cli.GetVirtualMachine(namespace, name)
+	if err != nil {
+		return err
+	}
+
+	// The virtual machine just got its configuration, there is nothing to restart yet.
+	resourceData.SetId(utils.BuildId(vm.ObjectMeta))
+
+	return resourceKubevirtVirtualMachineRestartRead(resourceData, meta)
+}
+
+func resourceKubevirtVirtualMachineRestartRead(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading virtual machine %s", name)
+
+	vm, err := cli.GetVirtualMachine(namespace, name)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Printf("[WARN] virtual machine %s is gone, removing its restart from state", name)
+			resourceData.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+
+	return resourceData.Set("restart_required", virtualmachine.RestartRequired(*vm))
+}
+
+// virtualMachineRestartCustomizeDiff plans an update, hence a restart, of virtual machines
+// reporting the RestartRequired condition when restart_when_required is set.
+func virtualMachineRestartCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	if diff.Get("restart_when_required").(bool) && diff.Get("restart_required").(bool) {
+		return diff.SetNew("restart_required", false)
+	}
+	return nil
+}
+
+func resourceKubevirtVirtualMachineRestartUpdate(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	oldRestartRequired, _ := resourceData.GetChange("restart_required")
+	restartRequired := oldRestartRequired.(bool) && resourceData.Get("restart_when_required").(bool)
+	if !resourceData.HasChange("triggers") && !restartRequired {
+		return resourceKubevirtVirtualMachineRestartRead(resourceData, meta)
+	}
+
+	vmi, err := cli.GetVirtualMachineInstance(namespace, name)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		// A stopped virtual machine picks up its configuration when it starts, there is nothing to restart.
+		log.Printf("[INFO] virtual machine %s is not running, not restarting it", name)
+		return resourceKubevirtVirtualMachineRestartRead(resourceData, meta)
+	}
+	// The restarted virtual machine is ready once it runs a new instance.
+	previousUID := vmi.UID
+
+	log.Printf("[INFO] Restarting virtual machine %s", name)
+	if err := cli.RestartVirtualMachine(namespace, name); err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Restarting"},
+		Target:  []string{"Ready"},
+		Timeout: resourceData.Timeout(schema.TimeoutUpdate),
+		Refresh: func() (interface{}, string, error) {
+			vm, err := cli.GetVirtualMachine(namespace, name)
+			if err != nil {
+				return vm, "", err
+			}
+			vmi, err := cli.GetVirtualMachineInstance(namespace, name)
+			if err != nil {
+				if errors.IsNotFound(err) {
+					log.Printf("[DEBUG] virtual machine %s is being restarted", name)
+					return vm, "Restarting", nil
+				}
+				return vm, "", err
+			}
+			if vmi.UID != previousUID && vm.Status.Ready {
+				return vm, "Ready", nil
+			}
+
+			log.Printf("[DEBUG] virtual machine %s is being restarted", name)
+			return vm, "Restarting", nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("%s", err)
+	}
+
+	log.Printf("[INFO] virtual machine %s restarted", name)
+
+	return resourceKubevirtVirtualMachineRestartRead(resourceData, meta)
+}
+
+func resourceKubevirtVirtualMachineRestartDelete(resourceData *schema.ResourceData, meta interface{}) error {
+	// Forgetting about the restart leaves the virtual machine as it is.
+	resourceData.SetId("")
+	return nil
+}
@@ -0,0 +1,125 @@
+package kubevirt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/client/mock"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/virtualmachine"
+	"gotest.tools/assert"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sschema "k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	kubevirtapiv1 "kubevirt.io/api/core/v1"
+)
+
+func TestResourceKubevirtVirtualMachineRestartUpdate(t *testing.T) {
+	notFound := errors.NewNotFound(k8sschema.GroupResource{Group: "kubevirt.io", Resource: "virtualmachineinstances"}, "vm")
+	readyVM := &kubevirtapiv1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{Name: "vm", Namespace: "default"},
+		Status:     kubevirtapiv1.VirtualMachineStatus{Ready: true},
+	}
+	restartRequiredVM := readyVM.DeepCopy()
+	restartRequiredVM.Status.Conditions = []kubevirtapiv1.VirtualMachineCondition{
+		{Type: virtualmachine.VirtualMachineRestartRequired, Status: k8sv1.ConditionTrue},
+	}
+	vmi := func(uid string) *kubevirtapiv1.VirtualMachineInstance {
+		return &kubevirtapiv1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "vm", Namespace: "default", UID: k8stypes.UID(uid)},
+		}
+	}
+
+	cases := []struct {
+		name                string
+		restartRequired     bool
+		restartWhenRequired []bool
+		triggers            []string
+		expect              func(cli *mock.MockClient)
+	}{
+		{
+			name:                "triggers changed",
+			restartWhenRequired: []bool{false, false},
+			triggers:            []string{"1", "2"},
+			expect: func(cli *mock.MockClient) {
+				gomock.InOrder(
+					cli.EXPECT().GetVirtualMachineInstance("default", "vm").Return(vmi("before"), nil),
+					cli.EXPECT().RestartVirtualMachine("default", "vm").Return(nil),
+					cli.EXPECT().GetVirtualMachine("default", "vm").Return(readyVM, nil),
+					cli.EXPECT().GetVirtualMachineInstance("default", "vm").Return(vmi("after"), nil),
+					cli.EXPECT().GetVirtualMachine("default", "vm").Return(readyVM, nil),
+				)
+			},
+		},
+		{
+			name:                "restart required",
+			restartRequired:     true,
+			restartWhenRequired: []bool{true, true},
+			triggers:            []string{"1", "1"},
+			expect: func(cli *mock.MockClient) {
+				gomock.InOrder(
+					cli.EXPECT().GetVirtualMachineInstance("default", "vm").Return(vmi("before"), nil),
+					cli.EXPECT().RestartVirtualMachine("default", "vm").Return(nil),
+					cli.EXPECT().GetVirtualMachine("default", "vm").Return(readyVM, nil),
+					cli.EXPECT().GetVirtualMachineInstance("default", "vm").Return(vmi("after"), nil),
+					cli.EXPECT().GetVirtualMachine("default", "vm").Return(readyVM, nil),
+				)
+			},
+		},
+		{
+			name:                "restart required but not wanted",
+			restartRequired:     true,
+			restartWhenRequired: []bool{true, false},
+			triggers:            []string{"1", "1"},
+			expect: func(cli *mock.MockClient) {
+				cli.EXPECT().GetVirtualMachine("default", "vm").Return(restartRequiredVM, nil)
+			},
+		},
+		{
+			name:                "stopped virtual machine",
+			restartWhenRequired: []bool{false, false},
+			triggers:            []string{"1", "2"},
+			expect: func(cli *mock.MockClient) {
+				gomock.InOrder(
+					cli.EXPECT().GetVirtualMachineInstance("default", "vm").Return(nil, notFound),
+					cli.EXPECT().GetVirtualMachine("default", "vm").Return(readyVM, nil),
+				)
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			cli := mock.NewMockClient(ctrl)
+			tc.expect(cli)
+
+			r := resourceKubevirtVirtualMachineRestart()
+			resourceData := r.TestResourceData()
+			assert.NilError(t, resourceData.Set("namespace", "default"))
+			assert.NilError(t, resourceData.Set("name", "vm"))
+			assert.NilError(t, resourceData.Set("triggers", map[string]interface{}{"image": tc.triggers[0]}))
+			assert.NilError(t, resourceData.Set("restart_when_required", tc.restartWhenRequired[0]))
+			assert.NilError(t, resourceData.Set("restart_required", tc.restartRequired))
+			resourceData.SetId("default/vm")
+			state := resourceData.State()
+
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"namespace":             "default",
+				"name":                  "vm",
+				"triggers":              map[string]interface{}{"image": tc.triggers[1]},
+				"restart_when_required": tc.restartWhenRequired[1],
+			})
+			diff, err := r.Diff(context.Background(), state, config, cli)
+			assert.NilError(t, err)
+			assert.Assert(t, diff != nil)
+
+			state, diags := r.Apply(context.Background(), state, diff, cli)
+			assert.Assert(t, !diags.HasError(), diags)
+			assert.Equal(t, state.ID, "default/vm")
+		})
+	}
+}
@@ -0,0 +1,530 @@
+package kubevirt
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/client/mock"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/virtualmachine"
+	"gotest.tools/assert"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sschema "k8s.io/apimachinery/pkg/runtime/schema"
+	kubevirtapiv1 "kubevirt.io/api/core/v1"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+)
+
+func virtualMachineWithRunStrategy(runStrategy kubevirtapiv1.VirtualMachineRunStrategy) *kubevirtapiv1.VirtualMachine {
+	return &kubevirtapiv1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{Name: "vm", Namespace: "default"},
+		Spec:       kubevirtapiv1.VirtualMachineSpec{RunStrategy: &runStrategy},
+	}
+}
+
+func TestVirtualMachineWaitFor(t *testing.T) {
+	cases := []struct {
+		name        string
+		runStrategy kubevirtapiv1.VirtualMachineRunStrategy
+		waitFor     []interface{}
+		expected    virtualmachine.WaitFor
+	}{
+		{
+			name:        "always",
+			runStrategy: kubevirtapiv1.RunStrategyAlways,
+			expected:    virtualmachine.WaitFor{Condition: virtualmachine.WaitForReady},
+		},
+		{
+			name:        "rerun on failure",
+			runStrategy: kubevirtapiv1.RunStrategyRerunOnFailure,
+			expected:    virtualmachine.WaitFor{Condition: virtualmachine.WaitForReady},
+		},
+		{
+			name:        "halted",
+			runStrategy: kubevirtapiv1.RunStrategyHalted,
+			expected:    virtualmachine.WaitFor{Condition: waitForProvisioned},
+		},
+		{
+			name:        "manual",
+			runStrategy: kubevirtapiv1.RunStrategyManual,
+			expected:    virtualmachine.WaitFor{Condition: waitForProvisioned},
+		},
+		{
+			name:        "wait_for overrides the run strategy",
+			runStrategy: kubevirtapiv1.RunStrategyHalted,
+			waitFor: []interface{}{map[string]interface{}{
+				"condition": virtualmachine.WaitForNone,
+			}},
+			expected: virtualmachine.WaitFor{Condition: virtualmachine.WaitForNone},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			raw := map[string]interface{}{}
+			if tc.waitFor != nil {
+				raw["wait_for"] = tc.waitFor
+			}
+			resourceData := schema.TestResourceDataRaw(t, virtualmachine.VirtualMachineFields(), raw)
+
+			waitFor, err := virtualMachineWaitFor(virtualMachineWithRunStrategy(tc.runStrategy), resourceData)
+			assert.NilError(t, err)
+			assert.DeepEqual(t, *waitFor, tc.expected)
+		})
+	}
+}
+
+func TestVirtualMachineDataVolumesProvisioned(t *testing.T) {
+	notFound := errors.NewNotFound(k8sschema.GroupResource{Group: "cdi.kubevirt.io", Resource: "datavolumes"}, "disk")
+
+	cases := []struct {
+		name          string
+		phase         cdiv1.DataVolumePhase
+		err           error
+		expected      bool
+		expectedError string
+	}{
+		{
+			name:     "succeeded",
+			phase:    cdiv1.Succeeded,
+			expected: true,
+		},
+		{
+			name:     "waiting for its first consumer",
+			phase:    cdiv1.WaitForFirstConsumer,
+			expected: true,
+		},
+		{
+			name:     "importing",
+			phase:    cdiv1.ImportInProgress,
+			expected: false,
+		},
+		{
+			name:     "not created yet",
+			err:      notFound,
+			expected: false,
+		},
+		{
+			name:          "failed",
+			phase:         cdiv1.Failed,
+			expectedError: "data volume disk failed to be provisioned, finished with phase=\"failed\"",
+		},
+		{
+			name:          "unreachable",
+			err:           fmt.Errorf("connection refused"),
+			expectedError: "connection refused",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			cli := mock.NewMockClient(ctrl)
+
+			vm := virtualMachineWithRunStrategy(kubevirtapiv1.RunStrategyHalted)
+			vm.Spec.DataVolumeTemplates = []kubevirtapiv1.DataVolumeTemplateSpec{
+				{ObjectMeta: metav1.ObjectMeta{Name: "disk"}},
+			}
+
+			if tc.err != nil {
+				cli.EXPECT().GetDataVolume("default", "disk").Return(nil, tc.err)
+			} else {
+				cli.EXPECT().GetDataVolume("default", "disk").Return(&cdiv1.DataVolume{
+					ObjectMeta: metav1.ObjectMeta{Name: "disk", Namespace: "default"},
+					Status:     cdiv1.DataVolumeStatus{Phase: tc.phase},
+				}, nil)
+			}
+
+			provisioned, err := virtualMachineDataVolumesProvisioned(cli, vm)
+			if tc.expectedError != "" {
+				assert.Error(t, err, tc.expectedError)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, provisioned, tc.expected)
+		})
+	}
+}
+
+func TestVirtualMachineInstanceReached(t *testing.T) {
+	agentConnected := kubevirtapiv1.VirtualMachineInstanceCondition{
+		Type:   kubevirtapiv1.VirtualMachineInstanceAgentConnected,
+		Status: k8sv1.ConditionTrue,
+	}
+	interfaces := []kubevirtapiv1.VirtualMachineInstanceNetworkInterface{
+		{Name: "default"},
+		{Name: "secondary", IP: "10.0.0.2"},
+	}
+
+	cases := []struct {
+		name     string
+		waitFor  virtualmachine.WaitFor
+		status   kubevirtapiv1.VirtualMachineInstanceStatus
+		expected bool
+	}{
+		{
+			name:     "running",
+			waitFor:  virtualmachine.WaitFor{Condition: virtualmachine.WaitForRunning},
+			status:   kubevirtapiv1.VirtualMachineInstanceStatus{Phase: kubevirtapiv1.Running},
+			expected: true,
+		},
+		{
+			name:     "scheduling",
+			waitFor:  virtualmachine.WaitFor{Condition: virtualmachine.WaitForRunning},
+			status:   kubevirtapiv1.VirtualMachineInstanceStatus{Phase: kubevirtapiv1.Scheduling},
+			expected: false,
+		},
+		{
+			name:    "agent connected",
+			waitFor: virtualmachine.WaitFor{Condition: virtualmachine.WaitForAgentConnected},
+			status: kubevirtapiv1.VirtualMachineInstanceStatus{
+				Phase:      kubevirtapiv1.Running,
+				Conditions: []kubevirtapiv1.VirtualMachineInstanceCondition{agentConnected},
+			},
+			expected: true,
+		},
+		{
+			name:     "agent not connected",
+			waitFor:  virtualmachine.WaitFor{Condition: virtualmachine.WaitForAgentConnected},
+			status:   kubevirtapiv1.VirtualMachineInstanceStatus{Phase: kubevirtapiv1.Running},
+			expected: false,
+		},
+		{
+			name:     "ip address on any interface",
+			waitFor:  virtualmachine.WaitFor{Condition: virtualmachine.WaitForIPAddress},
+			status:   kubevirtapiv1.VirtualMachineInstanceStatus{Interfaces: interfaces},
+			expected: true,
+		},
+		{
+			name:     "ip address on the interface",
+			waitFor:  virtualmachine.WaitFor{Condition: virtualmachine.WaitForIPAddress, Interface: "secondary"},
+			status:   kubevirtapiv1.VirtualMachineInstanceStatus{Interfaces: interfaces},
+			expected: true,
+		},
+		{
+			name:     "no ip address on the interface",
+			waitFor:  virtualmachine.WaitFor{Condition: virtualmachine.WaitForIPAddress, Interface: "default"},
+			status:   kubevirtapiv1.VirtualMachineInstanceStatus{Interfaces: interfaces},
+			expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			vmi := &kubevirtapiv1.VirtualMachineInstance{Status: tc.status}
+			assert.Equal(t, virtualMachineInstanceReached(vmi, &tc.waitFor), tc.expected)
+		})
+	}
+}
+
+func TestResourceKubevirtVirtualMachineCreateWaitFor(t *testing.T) {
+	readyVM := virtualMachineWithRunStrategy(kubevirtapiv1.RunStrategyAlways)
+	readyVM.Status = kubevirtapiv1.VirtualMachineStatus{Created: true, Ready: true}
+	runningVMI := &kubevirtapiv1.VirtualMachineInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "vm", Namespace: "default"},
+		Status: kubevirtapiv1.VirtualMachineInstanceStatus{
+			Phase: kubevirtapiv1.Running,
+			Conditions: []kubevirtapiv1.VirtualMachineInstanceCondition{
+				{Type: kubevirtapiv1.VirtualMachineInstanceAgentConnected, Status: k8sv1.ConditionTrue},
+			},
+			Interfaces: []kubevirtapiv1.VirtualMachineInstanceNetworkInterface{
+				{Name: "default", IP: "10.0.0.1"},
+			},
+		},
+	}
+
+	for _, condition := range []string{
+		virtualmachine.WaitForReady,
+		virtualmachine.WaitForRunning,
+		virtualmachine.WaitForAgentConnected,
+		virtualmachine.WaitForIPAddress,
+		virtualmachine.WaitForNone,
+	} {
+		t.Run(condition, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			cli := mock.NewMockClient(ctrl)
+
+			cli.EXPECT().CreateVirtualMachine(gomock.Any()).Return(nil)
+			cli.EXPECT().GetVirtualMachine("default", "vm").Return(readyVM, nil).AnyTimes()
+			cli.EXPECT().GetVirtualMachineInstance("default", "vm").Return(runningVMI, nil).AnyTimes()
+
+			resourceData := schema.TestResourceDataRaw(t, virtualmachine.VirtualMachineFields(), map[string]interface{}{
+				"metadata": []interface{}{map[string]interface{}{"name": "vm", "namespace": "default"}},
+				"spec":     []interface{}{map[string]interface{}{"run_strategy": "Always"}},
+				"wait_for": []interface{}{map[string]interface{}{"condition": condition}},
+			})
+
+			assert.NilError(t, resourceKubevirtVirtualMachineCreate(resourceData, cli))
+			assert.Equal(t, resourceData.Id(), "default/vm")
+		})
+	}
+}
+
+func TestResourceKubevirtVirtualMachineCreatePowerState(t *testing.T) {
+	stoppedVM := virtualMachineWithRunStrategy(kubevirtapiv1.RunStrategyManual)
+	stoppedVM.Status = kubevirtapiv1.VirtualMachineStatus{PrintableStatus: kubevirtapiv1.VirtualMachineStatusStopped}
+	runningVM := virtualMachineWithRunStrategy(kubevirtapiv1.RunStrategyManual)
+	runningVM.Status = kubevirtapiv1.VirtualMachineStatus{Created: true, Ready: true, PrintableStatus: kubevirtapiv1.VirtualMachineStatusRunning}
+	runningVMI := &kubevirtapiv1.VirtualMachineInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "vm", Namespace: "default"},
+		Status:     kubevirtapiv1.VirtualMachineInstanceStatus{Phase: kubevirtapiv1.Running},
+	}
+
+	ctrl := gomock.NewController(t)
+	cli := mock.NewMockClient(ctrl)
+
+	// The virtual machine is started before waiting for its instance, which would never run otherwise.
+	gomock.InOrder(
+		cli.EXPECT().CreateVirtualMachine(gomock.Any()).Return(nil),
+		cli.EXPECT().GetVirtualMachine("default", "vm").Return(stoppedVM, nil),
+		cli.EXPECT().StartVirtualMachine("default", "vm").Return(nil),
+		cli.EXPECT().GetVirtualMachine("default", "vm").Return(runningVM, nil),
+		cli.EXPECT().GetVirtualMachine("default", "vm").Return(runningVM, nil),
+		cli.EXPECT().GetVirtualMachineInstance("default", "vm").Return(runningVMI, nil),
+	)
+	cli.EXPECT().GetVirtualMachine("default", "vm").Return(runningVM, nil).AnyTimes()
+	cli.EXPECT().GetVirtualMachineInstance("default", "vm").Return(runningVMI, nil).AnyTimes()
+
+	resourceData := schema.TestResourceDataRaw(t, virtualmachine.VirtualMachineFields(), map[string]interface{}{
+		"metadata":    []interface{}{map[string]interface{}{"name": "vm", "namespace": "default"}},
+		"spec":        []interface{}{map[string]interface{}{"run_strategy": "Manual"}},
+		"power_state": virtualmachine.PowerStateRunning,
+		"wait_for":    []interface{}{map[string]interface{}{"condition": virtualmachine.WaitForRunning}},
+	})
+
+	assert.NilError(t, resourceKubevirtVirtualMachineCreate(resourceData, cli))
+	assert.Equal(t, resourceData.Get("power_state"), virtualmachine.PowerStateRunning)
+}
+
+func TestSetVirtualMachinePowerState(t *testing.T) {
+	stopped := &kubevirtapiv1.VirtualMachine{Status: kubevirtapiv1.VirtualMachineStatus{
+		PrintableStatus: kubevirtapiv1.VirtualMachineStatusStopped,
+	}}
+	running := &kubevirtapiv1.VirtualMachine{Status: kubevirtapiv1.VirtualMachineStatus{
+		Created:         true,
+		PrintableStatus: kubevirtapiv1.VirtualMachineStatusRunning,
+	}}
+	paused := &kubevirtapiv1.VirtualMachine{Status: kubevirtapiv1.VirtualMachineStatus{
+		Created:         true,
+		PrintableStatus: kubevirtapiv1.VirtualMachineStatusPaused,
+	}}
+
+	cases := []struct {
+		name       string
+		powerState string
+		expect     func(cli *mock.MockClientMockRecorder) []*gomock.Call
+	}{
+		{
+			name:       "start",
+			powerState: virtualmachine.PowerStateRunning,
+			expect: func(cli *mock.MockClientMockRecorder) []*gomock.Call {
+				return []*gomock.Call{
+					cli.GetVirtualMachine("default", "vm").Return(stopped, nil),
+					cli.StartVirtualMachine("default", "vm").Return(nil),
+					cli.GetVirtualMachine("default", "vm").Return(running, nil),
+				}
+			},
+		},
+		{
+			name:       "stop",
+			powerState: virtualmachine.PowerStateStopped,
+			expect: func(cli *mock.MockClientMockRecorder) []*gomock.Call {
+				return []*gomock.Call{
+					cli.GetVirtualMachine("default", "vm").Return(running, nil),
+					cli.StopVirtualMachine("default", "vm").Return(nil),
+					cli.GetVirtualMachine("default", "vm").Return(stopped, nil),
+				}
+			},
+		},
+		{
+			name:       "start paused",
+			powerState: virtualmachine.PowerStatePaused,
+			expect: func(cli *mock.MockClientMockRecorder) []*gomock.Call {
+				return []*gomock.Call{
+					cli.GetVirtualMachine("default", "vm").Return(stopped, nil),
+					cli.StartVirtualMachine("default", "vm").Return(nil),
+					cli.GetVirtualMachine("default", "vm").Return(running, nil),
+					cli.PauseVirtualMachineInstance("default", "vm").Return(nil),
+					cli.GetVirtualMachine("default", "vm").Return(paused, nil),
+				}
+			},
+		},
+		{
+			name:       "unpause",
+			powerState: virtualmachine.PowerStateRunning,
+			expect: func(cli *mock.MockClientMockRecorder) []*gomock.Call {
+				return []*gomock.Call{
+					cli.GetVirtualMachine("default", "vm").Return(paused, nil),
+					cli.UnpauseVirtualMachineInstance("default", "vm").Return(nil),
+					cli.GetVirtualMachine("default", "vm").Return(running, nil),
+				}
+			},
+		},
+		{
+			name:       "already running",
+			powerState: virtualmachine.PowerStateRunning,
+			expect: func(cli *mock.MockClientMockRecorder) []*gomock.Call {
+				return []*gomock.Call{
+					cli.GetVirtualMachine("default", "vm").Return(running, nil),
+					cli.GetVirtualMachine("default", "vm").Return(running, nil),
+				}
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			cli := mock.NewMockClient(ctrl)
+			gomock.InOrder(tc.expect(cli.EXPECT())...)
+
+			assert.NilError(t, setVirtualMachinePowerState(cli, "default", "vm", tc.powerState, time.Minute))
+		})
+	}
+}
+
+func TestVirtualMachineWaitForReachable(t *testing.T) {
+	cases := []struct {
+		name          string
+		runStrategy   kubevirtapiv1.VirtualMachineRunStrategy
+		powerState    string
+		waitFor       *virtualmachine.WaitFor
+		expectedError string
+	}{
+		{
+			name:        "running",
+			runStrategy: kubevirtapiv1.RunStrategyManual,
+			powerState:  virtualmachine.PowerStateRunning,
+			waitFor:     &virtualmachine.WaitFor{Condition: virtualmachine.WaitForAgentConnected},
+		},
+		{
+			name:        "stopped without wait_for",
+			runStrategy: kubevirtapiv1.RunStrategyManual,
+			powerState:  virtualmachine.PowerStateStopped,
+		},
+		{
+			name:        "stopped not waiting",
+			runStrategy: kubevirtapiv1.RunStrategyManual,
+			powerState:  virtualmachine.PowerStateStopped,
+			waitFor:     &virtualmachine.WaitFor{Condition: virtualmachine.WaitForNone},
+		},
+		{
+			name:          "stopped waiting for its instance",
+			runStrategy:   kubevirtapiv1.RunStrategyManual,
+			powerState:    virtualmachine.PowerStateStopped,
+			waitFor:       &virtualmachine.WaitFor{Condition: virtualmachine.WaitForRunning},
+			expectedError: "wait_for \"Running\" is never reached by a virtual machine created with power_state \"Stopped\"",
+		},
+		{
+			name:        "always running",
+			runStrategy: kubevirtapiv1.RunStrategyAlways,
+			waitFor:     &virtualmachine.WaitFor{Condition: virtualmachine.WaitForReady},
+		},
+		{
+			name:        "halted not waiting",
+			runStrategy: kubevirtapiv1.RunStrategyHalted,
+			waitFor:     &virtualmachine.WaitFor{Condition: virtualmachine.WaitForNone},
+		},
+		{
+			name:          "halted waiting for its instance",
+			runStrategy:   kubevirtapiv1.RunStrategyHalted,
+			waitFor:       &virtualmachine.WaitFor{Condition: virtualmachine.WaitForRunning},
+			expectedError: "wait_for \"Running\" is never reached by a virtual machine created with run strategy \"Halted\" and no power_state",
+		},
+		{
+			name:          "manual waiting for its instance",
+			runStrategy:   kubevirtapiv1.RunStrategyManual,
+			waitFor:       &virtualmachine.WaitFor{Condition: virtualmachine.WaitForReady},
+			expectedError: "wait_for \"Ready\" is never reached by a virtual machine created with run strategy \"Manual\" and no power_state",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := virtualMachineWaitForReachable(tc.runStrategy, tc.powerState, tc.waitFor)
+			if tc.expectedError != "" {
+				assert.Error(t, err, tc.expectedError)
+				return
+			}
+			assert.NilError(t, err)
+		})
+	}
+}
+
+func TestResourceKubevirtVirtualMachineWaitForDiff(t *testing.T) {
+	r := resourceKubevirtVirtualMachine()
+
+	config := func(runStrategy string) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"metadata": []interface{}{map[string]interface{}{"name": "vm", "namespace": "default"}},
+			"spec":     []interface{}{map[string]interface{}{"run_strategy": runStrategy}},
+			"wait_for": []interface{}{map[string]interface{}{"condition": virtualmachine.WaitForReady}},
+		})
+	}
+
+	_, err := r.Diff(context.Background(), nil, config("Always"), nil)
+	assert.NilError(t, err)
+
+	_, err = r.Diff(context.Background(), nil, config("Halted"), nil)
+	assert.ErrorContains(t, err, "never reached by a virtual machine created with run strategy \"Halted\"")
+}
+
+func TestResourceKubevirtVirtualMachineLogsNoSecretExtraHeaders(t *testing.T) {
+	var logs bytes.Buffer
+	log.SetOutput(&logs)
+	t.Cleanup(func() { log.SetOutput(os.Stderr) })
+
+	vm := virtualMachineWithRunStrategy(kubevirtapiv1.RunStrategyAlways)
+	vm.Spec.DataVolumeTemplates = []kubevirtapiv1.DataVolumeTemplateSpec{{
+		ObjectMeta: metav1.ObjectMeta{Name: "disk"},
+		Spec: cdiv1.DataVolumeSpec{
+			Source: &cdiv1.DataVolumeSource{
+				HTTP: &cdiv1.DataVolumeSourceHTTP{
+					URL:                "https://example.com/disk.img",
+					SecretExtraHeaders: []string{"registry-bearer-token"},
+				},
+			},
+		},
+	}}
+
+	ctrl := gomock.NewController(t)
+	cli := mock.NewMockClient(ctrl)
+
+	cli.EXPECT().CreateVirtualMachine(gomock.Any()).Return(nil)
+	cli.EXPECT().GetVirtualMachine("default", "vm").Return(vm, nil).AnyTimes()
+	cli.EXPECT().GetVirtualMachineInstance("default", "vm").Return(nil, errors.NewNotFound(k8sschema.GroupResource{Group: "kubevirt.io", Resource: "virtualmachineinstances"}, "vm")).AnyTimes()
+
+	resourceData := schema.TestResourceDataRaw(t, virtualmachine.VirtualMachineFields(), map[string]interface{}{
+		"metadata": []interface{}{map[string]interface{}{"name": "vm", "namespace": "default"}},
+		"spec": []interface{}{map[string]interface{}{
+			"run_strategy": "Always",
+			"data_volume_templates": []interface{}{map[string]interface{}{
+				"metadata": []interface{}{map[string]interface{}{"name": "disk"}},
+				"spec": []interface{}{map[string]interface{}{
+					"source": []interface{}{map[string]interface{}{
+						"http": []interface{}{map[string]interface{}{
+							"url":                  "https://example.com/disk.img",
+							"secret_extra_headers": []interface{}{"registry-bearer-token"},
+						}},
+					}},
+				}},
+			}},
+		}},
+		"wait_for": []interface{}{map[string]interface{}{"condition": virtualmachine.WaitForNone}},
+	})
+
+	assert.NilError(t, resourceKubevirtVirtualMachineCreate(resourceData, cli))
+	assert.Assert(t, !resourceKubevirtVirtualMachineRead(context.Background(), resourceData, cli).HasError())
+
+	assert.Assert(t, logs.Len() > 0)
+	assert.Assert(t, !strings.Contains(logs.String(), "registry-bearer-token"), logs.String())
+}
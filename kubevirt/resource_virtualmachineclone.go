@@ -0,0 +1,185 @@
+package kubevirt
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/client"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/virtualmachineclone"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils"
+	"k8s.io/apimachinery/pkg/api/errors"
+	clonev1alpha1 "kubevirt.io/api/clone/v1alpha1"
+)
+
+func resourceKubevirtVirtualMachineClone() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubevirtVirtualMachineCloneCreate,
+		Read:   resourceKubevirtVirtualMachineCloneRead,
+		Delete: resourceKubevirtVirtualMachineCloneDelete,
+		Exists: resourceKubevirtVirtualMachineCloneExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(40 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		// A clone runs once, any change requests another one. Deleting it keeps the target virtual machine.
+		Schema: utils.ForceNewSchema(virtualmachineclone.VirtualMachineCloneFields()),
+	}
+}
+
+func resourceKubevirtVirtualMachineCloneCreate(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	clone := virtualmachineclone.FromResourceData(resourceData)
+
+	log.Printf("[INFO] Creating new virtual machine clone: %#v", clone)
+	if err := cli.CreateVirtualMachineClone(clone); err != nil {
+		return err
+	}
+	log.Printf("[INFO] Submitted new virtual machine clone: %#v", clone)
+	if err := virtualmachineclone.ToResourceData(*clone, resourceData); err != nil {
+		return err
+	}
+	resourceData.SetId(utils.BuildId(clone.ObjectMeta))
+
+	// Wait for the clone to succeed:
+	name := clone.ObjectMeta.Name
+	namespace := clone.ObjectMeta.Namespace
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Cloning"},
+		Target:  []string{"Succeeded"},
+		Timeout: resourceData.Timeout(schema.TimeoutCreate),
+		Refresh: func() (interface{}, string, error) {
+			var err error
+			clone, err = cli.GetVirtualMachineClone(namespace, name)
+			if err != nil {
+				if errors.IsNotFound(err) {
+					log.Printf("[DEBUG] virtual machine clone %s is not created yet", name)
+					return clone, "Cloning", nil
+				}
+				return clone, "", err
+			}
+
+			switch clone.Status.Phase {
+			case clonev1alpha1.Succeeded:
+				return clone, "Succeeded", nil
+			case clonev1alpha1.Failed:
+				return clone, "", virtualMachineCloneError(clone)
+			}
+
+			log.Printf("[DEBUG] virtual machine clone %s is in phase %q", name, clone.Status.Phase)
+			return clone, "Cloning", nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("%s", err)
+	}
+
+	return resourceKubevirtVirtualMachineCloneRead(resourceData, meta)
+}
+
+// virtualMachineCloneError describes why the clone failed, from the messages of its conditions.
+func virtualMachineCloneError(clone *clonev1alpha1.VirtualMachineClone) error {
+	var reasons []string
+	for _, condition := range clone.Status.Conditions {
+		if condition.Message != "" {
+			reasons = append(reasons, condition.Message)
+		}
+	}
+
+	msg := fmt.Sprintf("virtual machine clone %s failed, finished with phase=\"failed\"", clone.Name)
+	if len(reasons) > 0 {
+		msg += ": " + strings.Join(reasons, "; ")
+	}
+	return fmt.Errorf(msg)
+}
+
+func resourceKubevirtVirtualMachineCloneRead(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading virtual machine clone %s", name)
+
+	clone, err := cli.GetVirtualMachineClone(namespace, name)
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received virtual machine clone: %#v", clone)
+
+	return virtualmachineclone.ToResourceData(*clone, resourceData)
+}
+
+func resourceKubevirtVirtualMachineCloneDelete(resourceData *schema.ResourceData, meta interface{}) error {
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	cli := (meta).(client.Client)
+
+	log.Printf("[INFO] Deleting virtual machine clone: %#v", name)
+	if err := cli.DeleteVirtualMachineClone(namespace, name); err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	// Wait for virtual machine clone to be removed:
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Deleting"},
+		Timeout: resourceData.Timeout(schema.TimeoutDelete),
+		Refresh: func() (interface{}, string, error) {
+			clone, err := cli.GetVirtualMachineClone(namespace, name)
+			if err != nil {
+				if errors.IsNotFound(err) {
+					return nil, "", nil
+				}
+				return clone, "", err
+			}
+
+			log.Printf("[DEBUG] Virtual machine clone %s is being deleted", clone.GetName())
+			return clone, "Deleting", nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("%s", err)
+	}
+
+	log.Printf("[INFO] virtual machine clone %s deleted", name)
+
+	resourceData.SetId("")
+	return nil
+}
+
+func resourceKubevirtVirtualMachineCloneExists(resourceData *schema.ResourceData, meta interface{}) (bool, error) {
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return false, err
+	}
+
+	cli := (meta).(client.Client)
+
+	log.Printf("[INFO] Checking virtual machine clone %s", name)
+	if _, err := cli.GetVirtualMachineClone(namespace, name); err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return true, err
+	}
+	return true, nil
+}
@@ -0,0 +1,149 @@
+package kubevirt
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/client"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/instancetype"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils/patch"
+	"k8s.io/apimachinery/pkg/api/errors"
+	instancetypev1alpha2 "kubevirt.io/api/instancetype/v1alpha2"
+)
+
+func resourceKubevirtVirtualMachineClusterInstancetype() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubevirtVirtualMachineClusterInstancetypeCreate,
+		Read:   resourceKubevirtVirtualMachineClusterInstancetypeRead,
+		Update: resourceKubevirtVirtualMachineClusterInstancetypeUpdate,
+		Delete: resourceKubevirtVirtualMachineClusterInstancetypeDelete,
+		Exists: resourceKubevirtVirtualMachineClusterInstancetypeExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: instancetype.InstancetypeFields("VirtualMachineClusterInstancetype", false),
+	}
+}
+
+func resourceKubevirtVirtualMachineClusterInstancetypeCreate(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	objectMeta, spec, err := instancetype.InstancetypeFromResourceData(resourceData)
+	if err != nil {
+		return err
+	}
+	vmInstancetype := &instancetypev1alpha2.VirtualMachineClusterInstancetype{ObjectMeta: objectMeta, Spec: spec}
+
+	log.Printf("[INFO] Creating new virtual machine cluster instancetype: %#v", vmInstancetype)
+	if err := cli.CreateVirtualMachineClusterInstancetype(vmInstancetype); err != nil {
+		return err
+	}
+	log.Printf("[INFO] Submitted new virtual machine cluster instancetype: %#v", vmInstancetype)
+
+	// Cluster instancetypes are cluster-scoped, their name is enough to identify them.
+	resourceData.SetId(vmInstancetype.Name)
+
+	return resourceKubevirtVirtualMachineClusterInstancetypeRead(resourceData, meta)
+}
+
+func resourceKubevirtVirtualMachineClusterInstancetypeRead(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	name := resourceData.Id()
+
+	log.Printf("[INFO] Reading virtual machine cluster instancetype %s", name)
+
+	vmInstancetype, err := cli.GetVirtualMachineClusterInstancetype(name)
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received virtual machine cluster instancetype: %#v", vmInstancetype)
+
+	return instancetype.InstancetypeToResourceData(vmInstancetype.ObjectMeta, vmInstancetype.Spec, resourceData)
+}
+
+func resourceKubevirtVirtualMachineClusterInstancetypeUpdate(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	name := resourceData.Id()
+
+	ops, err := instancetype.InstancetypeAppendPatchOps("", "", resourceData, make([]patch.PatchOperation, 0, 0))
+	if err != nil {
+		return err
+	}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("Failed to marshal update operations: %s", err)
+	}
+
+	log.Printf("[INFO] Updating virtual machine cluster instancetype: %s", ops)
+	out := &instancetypev1alpha2.VirtualMachineClusterInstancetype{}
+	if err := cli.UpdateVirtualMachineClusterInstancetype(name, out, data); err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Submitted updated virtual machine cluster instancetype: %#v", out)
+
+	return resourceKubevirtVirtualMachineClusterInstancetypeRead(resourceData, meta)
+}
+
+func resourceKubevirtVirtualMachineClusterInstancetypeDelete(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	name := resourceData.Id()
+
+	log.Printf("[INFO] Deleting virtual machine cluster instancetype: %#v", name)
+	if err := cli.DeleteVirtualMachineClusterInstancetype(name); err != nil {
+		return err
+	}
+
+	// Wait for virtual machine cluster instancetype to be removed:
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Deleting"},
+		Timeout: resourceData.Timeout(schema.TimeoutDelete),
+		Refresh: func() (interface{}, string, error) {
+			vmInstancetype, err := cli.GetVirtualMachineClusterInstancetype(name)
+			if err != nil {
+				if errors.IsNotFound(err) {
+					return nil, "", nil
+				}
+				return vmInstancetype, "", err
+			}
+
+			log.Printf("[DEBUG] Virtual machine cluster instancetype %s is being deleted", vmInstancetype.GetName())
+			return vmInstancetype, "Deleting", nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("%s", err)
+	}
+
+	log.Printf("[INFO] virtual machine cluster instancetype %s deleted", name)
+
+	resourceData.SetId("")
+	return nil
+}
+
+func resourceKubevirtVirtualMachineClusterInstancetypeExists(resourceData *schema.ResourceData, meta interface{}) (bool, error) {
+	cli := (meta).(client.Client)
+
+	name := resourceData.Id()
+
+	log.Printf("[INFO] Checking virtual machine cluster instancetype %s", name)
+	if _, err := cli.GetVirtualMachineClusterInstancetype(name); err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return true, err
+	}
+	return true, nil
+}
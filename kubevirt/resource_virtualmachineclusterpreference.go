@@ -0,0 +1,143 @@
+package kubevirt
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/client"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/instancetype"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils/patch"
+	"k8s.io/apimachinery/pkg/api/errors"
+	instancetypev1alpha2 "kubevirt.io/api/instancetype/v1alpha2"
+)
+
+func resourceKubevirtVirtualMachineClusterPreference() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubevirtVirtualMachineClusterPreferenceCreate,
+		Read:   resourceKubevirtVirtualMachineClusterPreferenceRead,
+		Update: resourceKubevirtVirtualMachineClusterPreferenceUpdate,
+		Delete: resourceKubevirtVirtualMachineClusterPreferenceDelete,
+		Exists: resourceKubevirtVirtualMachineClusterPreferenceExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: instancetype.PreferenceFields("VirtualMachineClusterPreference", false),
+	}
+}
+
+func resourceKubevirtVirtualMachineClusterPreferenceCreate(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	objectMeta, spec := instancetype.PreferenceFromResourceData(resourceData)
+	preference := &instancetypev1alpha2.VirtualMachineClusterPreference{ObjectMeta: objectMeta, Spec: spec}
+
+	log.Printf("[INFO] Creating new virtual machine cluster preference: %#v", preference)
+	if err := cli.CreateVirtualMachineClusterPreference(preference); err != nil {
+		return err
+	}
+	log.Printf("[INFO] Submitted new virtual machine cluster preference: %#v", preference)
+
+	// Cluster preferences are cluster-scoped, their name is enough to identify them.
+	resourceData.SetId(preference.Name)
+
+	return resourceKubevirtVirtualMachineClusterPreferenceRead(resourceData, meta)
+}
+
+func resourceKubevirtVirtualMachineClusterPreferenceRead(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	name := resourceData.Id()
+
+	log.Printf("[INFO] Reading virtual machine cluster preference %s", name)
+
+	preference, err := cli.GetVirtualMachineClusterPreference(name)
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received virtual machine cluster preference: %#v", preference)
+
+	return instancetype.PreferenceToResourceData(preference.ObjectMeta, preference.Spec, resourceData)
+}
+
+func resourceKubevirtVirtualMachineClusterPreferenceUpdate(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	name := resourceData.Id()
+
+	ops := instancetype.PreferenceAppendPatchOps("", "", resourceData, make([]patch.PatchOperation, 0, 0))
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("Failed to marshal update operations: %s", err)
+	}
+
+	log.Printf("[INFO] Updating virtual machine cluster preference: %s", ops)
+	out := &instancetypev1alpha2.VirtualMachineClusterPreference{}
+	if err := cli.UpdateVirtualMachineClusterPreference(name, out, data); err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Submitted updated virtual machine cluster preference: %#v", out)
+
+	return resourceKubevirtVirtualMachineClusterPreferenceRead(resourceData, meta)
+}
+
+func resourceKubevirtVirtualMachineClusterPreferenceDelete(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	name := resourceData.Id()
+
+	log.Printf("[INFO] Deleting virtual machine cluster preference: %#v", name)
+	if err := cli.DeleteVirtualMachineClusterPreference(name); err != nil {
+		return err
+	}
+
+	// Wait for virtual machine cluster preference to be removed:
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Deleting"},
+		Timeout: resourceData.Timeout(schema.TimeoutDelete),
+		Refresh: func() (interface{}, string, error) {
+			preference, err := cli.GetVirtualMachineClusterPreference(name)
+			if err != nil {
+				if errors.IsNotFound(err) {
+					return nil, "", nil
+				}
+				return preference, "", err
+			}
+
+			log.Printf("[DEBUG] Virtual machine cluster preference %s is being deleted", preference.GetName())
+			return preference, "Deleting", nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("%s", err)
+	}
+
+	log.Printf("[INFO] virtual machine cluster preference %s deleted", name)
+
+	resourceData.SetId("")
+	return nil
+}
+
+func resourceKubevirtVirtualMachineClusterPreferenceExists(resourceData *schema.ResourceData, meta interface{}) (bool, error) {
+	cli := (meta).(client.Client)
+
+	name := resourceData.Id()
+
+	log.Printf("[INFO] Checking virtual machine cluster preference %s", name)
+	if _, err := cli.GetVirtualMachineClusterPreference(name); err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return true, err
+	}
+	return true, nil
+}
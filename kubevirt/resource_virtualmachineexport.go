@@ -0,0 +1,206 @@
+package kubevirt
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/client"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/virtualmachineexport"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils/patch"
+	"k8s.io/apimachinery/pkg/api/errors"
+	exportv1alpha1 "kubevirt.io/api/export/v1alpha1"
+)
+
+func resourceKubevirtVirtualMachineExport() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubevirtVirtualMachineExportCreate,
+		Read:   resourceKubevirtVirtualMachineExportRead,
+		Update: resourceKubevirtVirtualMachineExportUpdate,
+		Delete: resourceKubevirtVirtualMachineExportDelete,
+		Exists: resourceKubevirtVirtualMachineExportExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(40 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: virtualmachineexport.VirtualMachineExportFields(),
+	}
+}
+
+func resourceKubevirtVirtualMachineExportCreate(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	export, err := virtualmachineexport.FromResourceData(resourceData)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating new virtual machine export: %#v", export)
+	if err := cli.CreateVirtualMachineExport(export); err != nil {
+		return err
+	}
+	log.Printf("[INFO] Submitted new virtual machine export: %#v", export)
+	if err := virtualmachineexport.ToResourceData(*export, resourceData); err != nil {
+		return err
+	}
+	resourceData.SetId(utils.BuildId(export.ObjectMeta))
+
+	// Wait for the export to be ready:
+	name := export.ObjectMeta.Name
+	namespace := export.ObjectMeta.Namespace
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Pending"},
+		Target:  []string{"Ready"},
+		Timeout: resourceData.Timeout(schema.TimeoutCreate),
+		Refresh: func() (interface{}, string, error) {
+			var err error
+			export, err = cli.GetVirtualMachineExport(namespace, name)
+			if err != nil {
+				if errors.IsNotFound(err) {
+					log.Printf("[DEBUG] virtual machine export %s is not created yet", name)
+					return export, "Pending", nil
+				}
+				return export, "", err
+			}
+
+			status := export.Status
+			if status == nil {
+				return export, "Pending", nil
+			}
+			switch status.Phase {
+			case exportv1alpha1.Ready:
+				return export, "Ready", nil
+			case exportv1alpha1.Terminated:
+				return export, "", fmt.Errorf("virtual machine export %s terminated before being ready", name)
+			case exportv1alpha1.Skipped:
+				// The source is in use, such as a running virtual machine, the export starts once it is released.
+				for _, condition := range status.Conditions {
+					if condition.Message != "" {
+						log.Printf("[DEBUG] virtual machine export %s is skipped: %s", name, condition.Message)
+					}
+				}
+			}
+
+			log.Printf("[DEBUG] virtual machine export %s is in phase %q", name, status.Phase)
+			return export, "Pending", nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("%s", err)
+	}
+
+	return resourceKubevirtVirtualMachineExportRead(resourceData, meta)
+}
+
+func resourceKubevirtVirtualMachineExportRead(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading virtual machine export %s", name)
+
+	export, err := cli.GetVirtualMachineExport(namespace, name)
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received virtual machine export: %#v", export)
+
+	return virtualmachineexport.ToResourceData(*export, resourceData)
+}
+
+func resourceKubevirtVirtualMachineExportUpdate(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	ops := virtualmachineexport.AppendPatchOps("", "", resourceData, make([]patch.PatchOperation, 0, 0))
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("Failed to marshal update operations: %s", err)
+	}
+
+	log.Printf("[INFO] Updating virtual machine export: %s", ops)
+	out := &exportv1alpha1.VirtualMachineExport{}
+	if err := cli.UpdateVirtualMachineExport(namespace, name, out, data); err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Submitted updated virtual machine export: %#v", out)
+
+	return resourceKubevirtVirtualMachineExportRead(resourceData, meta)
+}
+
+func resourceKubevirtVirtualMachineExportDelete(resourceData *schema.ResourceData, meta interface{}) error {
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	cli := (meta).(client.Client)
+
+	log.Printf("[INFO] Deleting virtual machine export: %#v", name)
+	if err := cli.DeleteVirtualMachineExport(namespace, name); err != nil {
+		return err
+	}
+
+	// Wait for virtual machine export to be removed:
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Deleting"},
+		Timeout: resourceData.Timeout(schema.TimeoutDelete),
+		Refresh: func() (interface{}, string, error) {
+			export, err := cli.GetVirtualMachineExport(namespace, name)
+			if err != nil {
+				if errors.IsNotFound(err) {
+					return nil, "", nil
+				}
+				return export, "", err
+			}
+
+			log.Printf("[DEBUG] Virtual machine export %s is being deleted", export.GetName())
+			return export, "Deleting", nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("%s", err)
+	}
+
+	log.Printf("[INFO] virtual machine export %s deleted", name)
+
+	resourceData.SetId("")
+	return nil
+}
+
+func resourceKubevirtVirtualMachineExportExists(resourceData *schema.ResourceData, meta interface{}) (bool, error) {
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return false, err
+	}
+
+	cli := (meta).(client.Client)
+
+	log.Printf("[INFO] Checking virtual machine export %s", name)
+	if _, err := cli.GetVirtualMachineExport(namespace, name); err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return true, err
+	}
+	return true, nil
+}
@@ -0,0 +1,260 @@
+package kubevirt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/client"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/virtualmachineinstance"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils/patch"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	kubevirtapiv1 "kubevirt.io/api/core/v1"
+)
+
+func resourceKubevirtVirtualMachineInstance() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceKubevirtVirtualMachineInstanceCreate,
+		ReadContext:   resourceKubevirtVirtualMachineInstanceRead,
+		Update:        resourceKubevirtVirtualMachineInstanceUpdate,
+		Delete:        resourceKubevirtVirtualMachineInstanceDelete,
+		Exists:        resourceKubevirtVirtualMachineInstanceExists,
+		CustomizeDiff: virtualMachineInstanceFailedCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(40 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: virtualmachineinstance.VirtualMachineInstanceFields(),
+	}
+}
+
+func resourceKubevirtVirtualMachineInstanceCreate(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	vmi, err := virtualmachineinstance.FromResourceData(resourceData)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating new virtual machine instance: %#v", vmi)
+	if err := cli.CreateVirtualMachineInstance(vmi); err != nil {
+		return err
+	}
+	log.Printf("[INFO] Submitted new virtual machine instance: %#v", vmi)
+	if err := virtualmachineinstance.ToResourceData(*vmi, resourceData); err != nil {
+		return err
+	}
+	resourceData.SetId(utils.BuildId(vmi.ObjectMeta))
+
+	// Wait for virtual machine instance to be running, or to have already run to completion:
+	name := vmi.ObjectMeta.Name
+	namespace := vmi.ObjectMeta.Namespace
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Creating"},
+		Target:  []string{"Succeeded"},
+		Timeout: resourceData.Timeout(schema.TimeoutCreate),
+		Refresh: func() (interface{}, string, error) {
+			var err error
+			vmi, err = cli.GetVirtualMachineInstance(namespace, name)
+			if err != nil {
+				if errors.IsNotFound(err) {
+					log.Printf("[DEBUG] virtual machine instance %s is not created yet", name)
+					return vmi, "Creating", nil
+				}
+				return vmi, "", err
+			}
+
+			switch vmi.Status.Phase {
+			case kubevirtapiv1.Running, kubevirtapiv1.Succeeded:
+				return vmi, "Succeeded", nil
+			case kubevirtapiv1.Failed:
+				return vmi, "", fmt.Errorf("virtual machine instance %s failed to start, finished with phase=\"failed\"", name)
+			}
+
+			log.Printf("[DEBUG] virtual machine instance %s is being created", name)
+			return vmi, "Creating", nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("%s", err)
+	}
+
+	_, err = readVirtualMachineInstance(resourceData, meta)
+	return err
+}
+
+func resourceKubevirtVirtualMachineInstanceRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vmi, err := readVirtualMachineInstance(resourceData, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return liveMigratabilityDiagnostics(vmi)
+}
+
+// readVirtualMachineInstance refreshes the state from the virtual machine instance and returns it.
+func readVirtualMachineInstance(resourceData *schema.ResourceData, meta interface{}) (*kubevirtapiv1.VirtualMachineInstance, error) {
+	cli := (meta).(client.Client)
+
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[INFO] Reading virtual machine instance %s", name)
+
+	vmi, err := cli.GetVirtualMachineInstance(namespace, name)
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return nil, err
+	}
+	log.Printf("[INFO] Received virtual machine instance: %#v", vmi)
+
+	return vmi, virtualmachineinstance.ToResourceData(*vmi, resourceData)
+}
+
+func resourceKubevirtVirtualMachineInstanceUpdate(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	ops := virtualmachineinstance.AppendPatchOps("", "", resourceData, make([]patch.PatchOperation, 0, 0))
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("Failed to marshal update operations: %s", err)
+	}
+
+	log.Printf("[INFO] Updating virtual machine instance: %s", ops)
+	out := &kubevirtapiv1.VirtualMachineInstance{}
+	if err := cli.UpdateVirtualMachineInstance(namespace, name, out, data); err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Submitted updated virtual machine instance: %#v", out)
+
+	_, err = readVirtualMachineInstance(resourceData, meta)
+	return err
+}
+
+// virtualMachineInstanceFailedCustomizeDiff replaces a failed virtual machine instance, KubeVirt
+// never restarts it. One which ran to completion is kept, its phase tells so.
+func virtualMachineInstanceFailedCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || diff.Get("status.0.phase").(string) != string(kubevirtapiv1.Failed) {
+		return nil
+	}
+
+	log.Printf("[WARN] virtual machine instance %s has failed, replacing it", diff.Id())
+	if err := diff.SetNewComputed("status"); err != nil {
+		return err
+	}
+	return diff.ForceNew("status")
+}
+
+// evictionStrategyLiveMigrateIfPossible live migrates the virtual machine instance on node drains
+// when it is live migratable and shuts it down otherwise.
+const evictionStrategyLiveMigrateIfPossible kubevirtapiv1.EvictionStrategy = "LiveMigrateIfPossible"
+
+// liveMigratabilityDiagnostics warns about virtual machine instances which are to be live migrated on
+// node drains but can't be, typically because one of their volumes isn't ReadWriteMany.
+func liveMigratabilityDiagnostics(vmi *kubevirtapiv1.VirtualMachineInstance) diag.Diagnostics {
+	if vmi == nil || vmi.Spec.EvictionStrategy == nil {
+		return nil
+	}
+
+	var consequence string
+	switch *vmi.Spec.EvictionStrategy {
+	case kubevirtapiv1.EvictionStrategyLiveMigrate:
+		consequence = "node drains are blocked until it is stopped"
+	case evictionStrategyLiveMigrateIfPossible:
+		consequence = "it is shut down instead on node drains"
+	default:
+		return nil
+	}
+
+	for _, condition := range vmi.Status.Conditions {
+		if condition.Type == kubevirtapiv1.VirtualMachineInstanceIsMigratable && condition.Status == k8sv1.ConditionFalse {
+			return diag.Diagnostics{{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Virtual machine instance %s isn't live migratable", vmi.Name),
+				Detail: fmt.Sprintf("It has eviction strategy %q but can't be live migrated (%s): %s. As a result, %s.",
+					*vmi.Spec.EvictionStrategy, condition.Reason, condition.Message, consequence),
+			}}
+		}
+	}
+	return nil
+}
+
+func resourceKubevirtVirtualMachineInstanceDelete(resourceData *schema.ResourceData, meta interface{}) error {
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	cli := (meta).(client.Client)
+
+	log.Printf("[INFO] Deleting virtual machine instance: %#v", name)
+	if err := cli.DeleteVirtualMachineInstance(namespace, name); err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	// Wait for virtual machine instance to be removed:
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Deleting"},
+		Timeout: resourceData.Timeout(schema.TimeoutDelete),
+		Refresh: func() (interface{}, string, error) {
+			vmi, err := cli.GetVirtualMachineInstance(namespace, name)
+			if err != nil {
+				if errors.IsNotFound(err) {
+					return nil, "", nil
+				}
+				return vmi, "", err
+			}
+
+			log.Printf("[DEBUG] Virtual machine instance %s is being deleted", vmi.GetName())
+			return vmi, "Deleting", nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("%s", err)
+	}
+
+	log.Printf("[INFO] virtual machine instance %s deleted", name)
+
+	resourceData.SetId("")
+	return nil
+}
+
+func resourceKubevirtVirtualMachineInstanceExists(resourceData *schema.ResourceData, meta interface{}) (bool, error) {
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return false, err
+	}
+
+	cli := (meta).(client.Client)
+
+	log.Printf("[INFO] Checking virtual machine instance %s", name)
+	if _, err := cli.GetVirtualMachineInstance(namespace, name); err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return true, err
+	}
+	return true, nil
+}
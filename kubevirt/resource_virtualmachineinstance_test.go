@@ -0,0 +1,159 @@
+package kubevirt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/virtualmachineinstance"
+	"gotest.tools/assert"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubevirtapiv1 "kubevirt.io/api/core/v1"
+)
+
+func TestResourceKubevirtVirtualMachineInstanceDiff(t *testing.T) {
+	gracePeriod := int64(30)
+	evictionStrategy := kubevirtapiv1.EvictionStrategyNone
+
+	// The instance as read back, with the defaults of the mutating webhook.
+	vmi := kubevirtapiv1.VirtualMachineInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "vmi", Namespace: "default", Labels: map[string]string{"app": "web"}},
+		Spec: kubevirtapiv1.VirtualMachineInstanceSpec{
+			Domain: kubevirtapiv1.DomainSpec{
+				Resources: kubevirtapiv1.ResourceRequirements{
+					Requests: k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse("1Gi")},
+				},
+				Machine: &kubevirtapiv1.Machine{Type: "q35"},
+				Devices: kubevirtapiv1.Devices{
+					Interfaces: []kubevirtapiv1.Interface{{
+						Name:                   "default",
+						InterfaceBindingMethod: kubevirtapiv1.InterfaceBindingMethod{Bridge: &kubevirtapiv1.InterfaceBridge{}},
+					}},
+				},
+			},
+			Networks: []kubevirtapiv1.Network{{
+				Name:          "default",
+				NetworkSource: kubevirtapiv1.NetworkSource{Pod: &kubevirtapiv1.PodNetwork{}},
+			}},
+			EvictionStrategy:              &evictionStrategy,
+			TerminationGracePeriodSeconds: &gracePeriod,
+		},
+	}
+
+	r := resourceKubevirtVirtualMachineInstance()
+	resourceData := r.TestResourceData()
+	assert.NilError(t, virtualmachineinstance.ToResourceData(vmi, resourceData))
+	resourceData.SetId("default/vmi")
+	state := resourceData.State()
+
+	config := func(labels map[string]interface{}) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"metadata": []interface{}{map[string]interface{}{
+				"name":      "vmi",
+				"namespace": "default",
+				"labels":    labels,
+			}},
+			"spec": []interface{}{map[string]interface{}{
+				"domain": []interface{}{map[string]interface{}{
+					"resources": []interface{}{map[string]interface{}{
+						"requests": map[string]interface{}{"memory": "1Gi"},
+					}},
+					"devices": []interface{}{map[string]interface{}{
+						"interface": []interface{}{map[string]interface{}{
+							"name":                     "default",
+							"interface_binding_method": "InterfaceBridge",
+						}},
+					}},
+				}},
+			}},
+		})
+	}
+
+	diff, err := r.Diff(context.Background(), state, config(map[string]interface{}{"app": "web"}), nil)
+	assert.NilError(t, err)
+	if diff != nil {
+		for k, v := range diff.Attributes {
+			t.Errorf("unexpected change of %s: %#v", k, v)
+		}
+	}
+
+	diff, err = r.Diff(context.Background(), state, config(map[string]interface{}{"app": "db"}), nil)
+	assert.NilError(t, err)
+	assert.Assert(t, !diff.RequiresNew())
+	assert.Equal(t, diff.Attributes["metadata.0.labels.app"].New, "db")
+
+	vmi.Status.Phase = kubevirtapiv1.Failed
+	assert.NilError(t, virtualmachineinstance.ToResourceData(vmi, resourceData))
+	diff, err = r.Diff(context.Background(), resourceData.State(), config(map[string]interface{}{"app": "web"}), nil)
+	assert.NilError(t, err)
+	assert.Assert(t, diff.RequiresNew())
+}
+
+func TestLiveMigratabilityDiagnostics(t *testing.T) {
+	notMigratable := kubevirtapiv1.VirtualMachineInstanceCondition{
+		Type:    kubevirtapiv1.VirtualMachineInstanceIsMigratable,
+		Status:  k8sv1.ConditionFalse,
+		Reason:  kubevirtapiv1.VirtualMachineInstanceReasonDisksNotMigratable,
+		Message: "PVC rootdisk is not shared",
+	}
+	migratable := kubevirtapiv1.VirtualMachineInstanceCondition{
+		Type:   kubevirtapiv1.VirtualMachineInstanceIsMigratable,
+		Status: k8sv1.ConditionTrue,
+	}
+
+	cases := []struct {
+		name             string
+		evictionStrategy kubevirtapiv1.EvictionStrategy
+		condition        kubevirtapiv1.VirtualMachineInstanceCondition
+		expectedDetail   string
+	}{
+		{
+			name:             "live migrate",
+			evictionStrategy: kubevirtapiv1.EvictionStrategyLiveMigrate,
+			condition:        notMigratable,
+			expectedDetail:   "It has eviction strategy \"LiveMigrate\" but can't be live migrated (DisksNotLiveMigratable): PVC rootdisk is not shared. As a result, node drains are blocked until it is stopped.",
+		},
+		{
+			name:             "live migrate if possible",
+			evictionStrategy: evictionStrategyLiveMigrateIfPossible,
+			condition:        notMigratable,
+			expectedDetail:   "It has eviction strategy \"LiveMigrateIfPossible\" but can't be live migrated (DisksNotLiveMigratable): PVC rootdisk is not shared. As a result, it is shut down instead on node drains.",
+		},
+		{
+			name:             "live migratable",
+			evictionStrategy: kubevirtapiv1.EvictionStrategyLiveMigrate,
+			condition:        migratable,
+		},
+		{
+			name:             "not to be live migrated",
+			evictionStrategy: kubevirtapiv1.EvictionStrategyNone,
+			condition:        notMigratable,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			evictionStrategy := tc.evictionStrategy
+			vmi := &kubevirtapiv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{Name: "vmi"},
+				Spec:       kubevirtapiv1.VirtualMachineInstanceSpec{EvictionStrategy: &evictionStrategy},
+				Status: kubevirtapiv1.VirtualMachineInstanceStatus{
+					Conditions: []kubevirtapiv1.VirtualMachineInstanceCondition{tc.condition},
+				},
+			}
+
+			diags := liveMigratabilityDiagnostics(vmi)
+			if tc.expectedDetail == "" {
+				assert.Equal(t, len(diags), 0)
+				return
+			}
+			assert.Equal(t, len(diags), 1)
+			assert.Equal(t, diags[0].Severity, diag.Warning)
+			assert.Equal(t, diags[0].Summary, "Virtual machine instance vmi isn't live migratable")
+			assert.Equal(t, diags[0].Detail, tc.expectedDetail)
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package kubevirt
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/client"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/virtualmachineinstancemigration"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	kubevirtapiv1 "kubevirt.io/api/core/v1"
+)
+
+func resourceKubevirtVirtualMachineInstanceMigration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubevirtVirtualMachineInstanceMigrationCreate,
+		Read:   resourceKubevirtVirtualMachineInstanceMigrationRead,
+		Delete: resourceKubevirtVirtualMachineInstanceMigrationDelete,
+		Exists: resourceKubevirtVirtualMachineInstanceMigrationExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(40 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		// A migration runs once, any change requests another one.
+		Schema: utils.ForceNewSchema(virtualmachineinstancemigration.VirtualMachineInstanceMigrationFields()),
+	}
+}
+
+func resourceKubevirtVirtualMachineInstanceMigrationCreate(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	migration := virtualmachineinstancemigration.FromResourceData(resourceData)
+
+	log.Printf("[INFO] Creating new virtual machine instance migration: %#v", migration)
+	if err := cli.CreateVirtualMachineInstanceMigration(migration); err != nil {
+		return err
+	}
+	log.Printf("[INFO] Submitted new virtual machine instance migration: %#v", migration)
+	if err := virtualmachineinstancemigration.ToResourceData(*migration, resourceData); err != nil {
+		return err
+	}
+	resourceData.SetId(utils.BuildId(migration.ObjectMeta))
+
+	// Wait for the migration to complete:
+	name := migration.ObjectMeta.Name
+	namespace := migration.ObjectMeta.Namespace
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Migrating"},
+		Target:  []string{"Succeeded"},
+		Timeout: resourceData.Timeout(schema.TimeoutCreate),
+		Refresh: func() (interface{}, string, error) {
+			var err error
+			migration, err = cli.GetVirtualMachineInstanceMigration(namespace, name)
+			if err != nil {
+				if errors.IsNotFound(err) {
+					log.Printf("[DEBUG] virtual machine instance migration %s is not created yet", name)
+					return migration, "Migrating", nil
+				}
+				return migration, "", err
+			}
+
+			switch migration.Status.Phase {
+			case kubevirtapiv1.MigrationSucceeded:
+				return migration, "Succeeded", nil
+			case kubevirtapiv1.MigrationFailed:
+				return migration, "", virtualMachineInstanceMigrationError(cli, migration)
+			}
+
+			log.Printf("[DEBUG] virtual machine instance migration %s is in phase %q", name, migration.Status.Phase)
+			return migration, "Migrating", nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("%s", err)
+	}
+
+	return resourceKubevirtVirtualMachineInstanceMigrationRead(resourceData, meta)
+}
+
+// virtualMachineInstanceMigrationError describes why the migration failed, from its conditions
+// and from the migration state reported by the virtual machine instance.
+func virtualMachineInstanceMigrationError(cli client.Client, migration *kubevirtapiv1.VirtualMachineInstanceMigration) error {
+	var reasons []string
+	for _, condition := range migration.Status.Conditions {
+		if condition.Status == k8sv1.ConditionTrue && condition.Message != "" {
+			reasons = append(reasons, condition.Message)
+		}
+	}
+
+	vmi, err := cli.GetVirtualMachineInstance(migration.Namespace, migration.Spec.VMIName)
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+	} else if state := vmi.Status.MigrationState; state != nil && state.MigrationUID == migration.UID {
+		reasons = append(reasons, fmt.Sprintf("migration from node %q to node %q (mode=%q, completed=%t, failed=%t, abortStatus=%q)",
+			state.SourceNode, state.TargetNode, state.Mode, state.Completed, state.Failed, state.AbortStatus))
+	}
+
+	msg := fmt.Sprintf("virtual machine instance migration %s of %s failed, finished with phase=\"failed\"", migration.Name, migration.Spec.VMIName)
+	if len(reasons) > 0 {
+		msg += ": " + strings.Join(reasons, "; ")
+	}
+	return fmt.Errorf(msg)
+}
+
+func resourceKubevirtVirtualMachineInstanceMigrationRead(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading virtual machine instance migration %s", name)
+
+	migration, err := cli.GetVirtualMachineInstanceMigration(namespace, name)
+	if err != nil {
+		if errors.IsNotFound(err) && virtualMachineInstanceMigrationSucceeded(resourceData) {
+			log.Printf("[INFO] Virtual machine instance migration %s succeeded and was garbage collected", name)
+			return nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received virtual machine instance migration: %#v", migration)
+
+	return virtualmachineinstancemigration.ToResourceData(*migration, resourceData)
+}
+
+func resourceKubevirtVirtualMachineInstanceMigrationDelete(resourceData *schema.ResourceData, meta interface{}) error {
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	cli := (meta).(client.Client)
+
+	log.Printf("[INFO] Deleting virtual machine instance migration: %#v", name)
+	if err := cli.DeleteVirtualMachineInstanceMigration(namespace, name); err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	// Wait for virtual machine instance migration to be removed:
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Deleting"},
+		Timeout: resourceData.Timeout(schema.TimeoutDelete),
+		Refresh: func() (interface{}, string, error) {
+			migration, err := cli.GetVirtualMachineInstanceMigration(namespace, name)
+			if err != nil {
+				if errors.IsNotFound(err) {
+					return nil, "", nil
+				}
+				return migration, "", err
+			}
+
+			log.Printf("[DEBUG] Virtual machine instance migration %s is being deleted", migration.GetName())
+			return migration, "Deleting", nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("%s", err)
+	}
+
+	log.Printf("[INFO] virtual machine instance migration %s deleted", name)
+
+	resourceData.SetId("")
+	return nil
+}
+
+func resourceKubevirtVirtualMachineInstanceMigrationExists(resourceData *schema.ResourceData, meta interface{}) (bool, error) {
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return false, err
+	}
+
+	cli := (meta).(client.Client)
+
+	log.Printf("[INFO] Checking virtual machine instance migration %s", name)
+	if _, err := cli.GetVirtualMachineInstanceMigration(namespace, name); err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return virtualMachineInstanceMigrationSucceeded(resourceData), nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return true, err
+	}
+	return true, nil
+}
+
+// virtualMachineInstanceMigrationSucceeded tells whether the migration is known to have succeeded.
+// KubeVirt garbage collects finished migrations, those keep standing for the migration that happened.
+func virtualMachineInstanceMigrationSucceeded(resourceData *schema.ResourceData) bool {
+	return resourceData.Get("status.0.phase").(string) == string(kubevirtapiv1.MigrationSucceeded)
+}
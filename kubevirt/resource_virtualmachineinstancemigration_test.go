@@ -0,0 +1,64 @@
+package kubevirt
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/client/mock"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/virtualmachineinstancemigration"
+	"gotest.tools/assert"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sschema "k8s.io/apimachinery/pkg/runtime/schema"
+	kubevirtapiv1 "kubevirt.io/api/core/v1"
+)
+
+func TestResourceKubevirtVirtualMachineInstanceMigrationGarbageCollected(t *testing.T) {
+	notFound := errors.NewNotFound(k8sschema.GroupResource{Group: "kubevirt.io", Resource: "virtualmachineinstancemigrations"}, "migration")
+
+	cases := []struct {
+		name   string
+		phase  kubevirtapiv1.VirtualMachineInstanceMigrationPhase
+		exists bool
+	}{
+		{
+			name:   "succeeded",
+			phase:  kubevirtapiv1.MigrationSucceeded,
+			exists: true,
+		},
+		{
+			name:   "running",
+			phase:  kubevirtapiv1.MigrationRunning,
+			exists: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			cli := mock.NewMockClient(ctrl)
+			cli.EXPECT().GetVirtualMachineInstanceMigration("default", "migration").Return(nil, notFound).AnyTimes()
+
+			resourceData := resourceKubevirtVirtualMachineInstanceMigration().TestResourceData()
+			assert.NilError(t, virtualmachineinstancemigration.ToResourceData(kubevirtapiv1.VirtualMachineInstanceMigration{
+				ObjectMeta: metav1.ObjectMeta{Name: "migration", Namespace: "default"},
+				Spec:       kubevirtapiv1.VirtualMachineInstanceMigrationSpec{VMIName: "vmi"},
+				Status:     kubevirtapiv1.VirtualMachineInstanceMigrationStatus{Phase: tc.phase},
+			}, resourceData))
+			resourceData.SetId("default/migration")
+
+			exists, err := resourceKubevirtVirtualMachineInstanceMigrationExists(resourceData, cli)
+			assert.NilError(t, err)
+			assert.Equal(t, exists, tc.exists)
+
+			err = resourceKubevirtVirtualMachineInstanceMigrationRead(resourceData, cli)
+			if tc.exists {
+				assert.NilError(t, err)
+				assert.Equal(t, resourceData.Id(), "default/migration")
+				assert.Equal(t, resourceData.Get("spec.0.vmi_name"), "vmi")
+			} else {
+				assert.Assert(t, errors.IsNotFound(err))
+			}
+		})
+	}
+}
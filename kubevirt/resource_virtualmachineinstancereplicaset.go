@@ -0,0 +1,214 @@
+package kubevirt
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/client"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/virtualmachineinstancereplicaset"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils/patch"
+	"k8s.io/apimachinery/pkg/api/errors"
+	kubevirtapiv1 "kubevirt.io/api/core/v1"
+)
+
+func resourceKubevirtVirtualMachineInstanceReplicaSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubevirtVirtualMachineInstanceReplicaSetCreate,
+		Read:   resourceKubevirtVirtualMachineInstanceReplicaSetRead,
+		Update: resourceKubevirtVirtualMachineInstanceReplicaSetUpdate,
+		Delete: resourceKubevirtVirtualMachineInstanceReplicaSetDelete,
+		Exists: resourceKubevirtVirtualMachineInstanceReplicaSetExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(40 * time.Minute),
+			Update: schema.DefaultTimeout(40 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: virtualmachineinstancereplicaset.VirtualMachineInstanceReplicaSetFields(),
+	}
+}
+
+func resourceKubevirtVirtualMachineInstanceReplicaSetCreate(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	replicaSet, err := virtualmachineinstancereplicaset.FromResourceData(resourceData)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating new virtual machine instance replica set: %#v", replicaSet)
+	if err := cli.CreateVirtualMachineInstanceReplicaSet(replicaSet); err != nil {
+		return err
+	}
+	log.Printf("[INFO] Submitted new virtual machine instance replica set: %#v", replicaSet)
+	if err := virtualmachineinstancereplicaset.ToResourceData(*replicaSet, resourceData); err != nil {
+		return err
+	}
+	resourceData.SetId(utils.BuildId(replicaSet.ObjectMeta))
+
+	if err := waitForVirtualMachineInstanceReplicaSetReady(cli, replicaSet.Namespace, replicaSet.Name, resourceData.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourceKubevirtVirtualMachineInstanceReplicaSetRead(resourceData, meta)
+}
+
+// waitForVirtualMachineInstanceReplicaSetReady waits for every replica of the replica set to be ready, unless the replica set is paused
+// since its controller doesn't reconcile the replicas then.
+func waitForVirtualMachineInstanceReplicaSetReady(cli client.Client, namespace, name string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Scaling"},
+		Target:  []string{"Ready"},
+		Timeout: timeout,
+		Refresh: func() (interface{}, string, error) {
+			replicaSet, err := cli.GetVirtualMachineInstanceReplicaSet(namespace, name)
+			if err != nil {
+				if errors.IsNotFound(err) {
+					log.Printf("[DEBUG] virtual machine instance replica set %s is not created yet", name)
+					return replicaSet, "Scaling", nil
+				}
+				return replicaSet, "", err
+			}
+
+			if replicaSet.Spec.Paused {
+				log.Printf("[DEBUG] virtual machine instance replica set %s is paused, not waiting for its replicas", name)
+				return replicaSet, "Ready", nil
+			}
+			replicas := int32(1)
+			if replicaSet.Spec.Replicas != nil {
+				replicas = *replicaSet.Spec.Replicas
+			}
+			if replicaSet.Status.Replicas == replicas && replicaSet.Status.ReadyReplicas == replicas {
+				return replicaSet, "Ready", nil
+			}
+
+			log.Printf("[DEBUG] virtual machine instance replica set %s has %d ready replicas out of %d, waiting for %d", name, replicaSet.Status.ReadyReplicas, replicaSet.Status.Replicas, replicas)
+			return replicaSet, "Scaling", nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("%s", err)
+	}
+	return nil
+}
+
+func resourceKubevirtVirtualMachineInstanceReplicaSetRead(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading virtual machine instance replica set %s", name)
+
+	replicaSet, err := cli.GetVirtualMachineInstanceReplicaSet(namespace, name)
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received virtual machine instance replica set: %#v", replicaSet)
+
+	return virtualmachineinstancereplicaset.ToResourceData(*replicaSet, resourceData)
+}
+
+func resourceKubevirtVirtualMachineInstanceReplicaSetUpdate(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	ops, err := virtualmachineinstancereplicaset.AppendPatchOps("", "", resourceData, make([]patch.PatchOperation, 0, 0))
+	if err != nil {
+		return err
+	}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("Failed to marshal update operations: %s", err)
+	}
+
+	log.Printf("[INFO] Updating virtual machine instance replica set: %s", ops)
+	out := &kubevirtapiv1.VirtualMachineInstanceReplicaSet{}
+	if err := cli.UpdateVirtualMachineInstanceReplicaSet(namespace, name, out, data); err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Submitted updated virtual machine instance replica set: %#v", out)
+
+	if resourceData.HasChange("spec") {
+		if err := waitForVirtualMachineInstanceReplicaSetReady(cli, namespace, name, resourceData.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceKubevirtVirtualMachineInstanceReplicaSetRead(resourceData, meta)
+}
+
+func resourceKubevirtVirtualMachineInstanceReplicaSetDelete(resourceData *schema.ResourceData, meta interface{}) error {
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	cli := (meta).(client.Client)
+
+	log.Printf("[INFO] Deleting virtual machine instance replica set: %#v", name)
+	if err := cli.DeleteVirtualMachineInstanceReplicaSet(namespace, name); err != nil {
+		return err
+	}
+
+	// Wait for virtual machine instance replica set to be removed:
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Deleting"},
+		Timeout: resourceData.Timeout(schema.TimeoutDelete),
+		Refresh: func() (interface{}, string, error) {
+			replicaSet, err := cli.GetVirtualMachineInstanceReplicaSet(namespace, name)
+			if err != nil {
+				if errors.IsNotFound(err) {
+					return nil, "", nil
+				}
+				return replicaSet, "", err
+			}
+
+			log.Printf("[DEBUG] Virtual machine instance replica set %s is being deleted", replicaSet.GetName())
+			return replicaSet, "Deleting", nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("%s", err)
+	}
+
+	log.Printf("[INFO] virtual machine instance replica set %s deleted", name)
+
+	resourceData.SetId("")
+	return nil
+}
+
+func resourceKubevirtVirtualMachineInstanceReplicaSetExists(resourceData *schema.ResourceData, meta interface{}) (bool, error) {
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return false, err
+	}
+
+	cli := (meta).(client.Client)
+
+	log.Printf("[INFO] Checking virtual machine instance replica set %s", name)
+	if _, err := cli.GetVirtualMachineInstanceReplicaSet(namespace, name); err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return true, err
+	}
+	return true, nil
+}
@@ -0,0 +1,161 @@
+package kubevirt
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/client"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/instancetype"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils/patch"
+	"k8s.io/apimachinery/pkg/api/errors"
+	instancetypev1alpha2 "kubevirt.io/api/instancetype/v1alpha2"
+)
+
+func resourceKubevirtVirtualMachineInstancetype() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubevirtVirtualMachineInstancetypeCreate,
+		Read:   resourceKubevirtVirtualMachineInstancetypeRead,
+		Update: resourceKubevirtVirtualMachineInstancetypeUpdate,
+		Delete: resourceKubevirtVirtualMachineInstancetypeDelete,
+		Exists: resourceKubevirtVirtualMachineInstancetypeExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: instancetype.InstancetypeFields("VirtualMachineInstancetype", true),
+	}
+}
+
+func resourceKubevirtVirtualMachineInstancetypeCreate(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	objectMeta, spec, err := instancetype.InstancetypeFromResourceData(resourceData)
+	if err != nil {
+		return err
+	}
+	vmInstancetype := &instancetypev1alpha2.VirtualMachineInstancetype{ObjectMeta: objectMeta, Spec: spec}
+
+	log.Printf("[INFO] Creating new virtual machine instancetype: %#v", vmInstancetype)
+	if err := cli.CreateVirtualMachineInstancetype(vmInstancetype); err != nil {
+		return err
+	}
+	log.Printf("[INFO] Submitted new virtual machine instancetype: %#v", vmInstancetype)
+
+	resourceData.SetId(utils.BuildId(vmInstancetype.ObjectMeta))
+
+	return resourceKubevirtVirtualMachineInstancetypeRead(resourceData, meta)
+}
+
+func resourceKubevirtVirtualMachineInstancetypeRead(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading virtual machine instancetype %s", name)
+
+	vmInstancetype, err := cli.GetVirtualMachineInstancetype(namespace, name)
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received virtual machine instancetype: %#v", vmInstancetype)
+
+	return instancetype.InstancetypeToResourceData(vmInstancetype.ObjectMeta, vmInstancetype.Spec, resourceData)
+}
+
+func resourceKubevirtVirtualMachineInstancetypeUpdate(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	ops, err := instancetype.InstancetypeAppendPatchOps("", "", resourceData, make([]patch.PatchOperation, 0, 0))
+	if err != nil {
+		return err
+	}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("Failed to marshal update operations: %s", err)
+	}
+
+	log.Printf("[INFO] Updating virtual machine instancetype: %s", ops)
+	out := &instancetypev1alpha2.VirtualMachineInstancetype{}
+	if err := cli.UpdateVirtualMachineInstancetype(namespace, name, out, data); err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Submitted updated virtual machine instancetype: %#v", out)
+
+	return resourceKubevirtVirtualMachineInstancetypeRead(resourceData, meta)
+}
+
+func resourceKubevirtVirtualMachineInstancetypeDelete(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting virtual machine instancetype: %#v", name)
+	if err := cli.DeleteVirtualMachineInstancetype(namespace, name); err != nil {
+		return err
+	}
+
+	// Wait for virtual machine instancetype to be removed:
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Deleting"},
+		Timeout: resourceData.Timeout(schema.TimeoutDelete),
+		Refresh: func() (interface{}, string, error) {
+			vmInstancetype, err := cli.GetVirtualMachineInstancetype(namespace, name)
+			if err != nil {
+				if errors.IsNotFound(err) {
+					return nil, "", nil
+				}
+				return vmInstancetype, "", err
+			}
+
+			log.Printf("[DEBUG] Virtual machine instancetype %s is being deleted", vmInstancetype.GetName())
+			return vmInstancetype, "Deleting", nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("%s", err)
+	}
+
+	log.Printf("[INFO] virtual machine instancetype %s deleted", name)
+
+	resourceData.SetId("")
+	return nil
+}
+
+func resourceKubevirtVirtualMachineInstancetypeExists(resourceData *schema.ResourceData, meta interface{}) (bool, error) {
+	cli := (meta).(client.Client)
+
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return false, err
+	}
+
+	log.Printf("[INFO] Checking virtual machine instancetype %s", name)
+	if _, err := cli.GetVirtualMachineInstancetype(namespace, name); err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return true, err
+	}
+	return true, nil
+}
@@ -0,0 +1,229 @@
+package kubevirt
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/client"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/virtualmachinepool"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils/patch"
+	"k8s.io/apimachinery/pkg/api/errors"
+	kubevirtapiv1 "kubevirt.io/api/core/v1"
+	poolv1alpha1 "kubevirt.io/api/pool/v1alpha1"
+)
+
+func resourceKubevirtVirtualMachinePool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubevirtVirtualMachinePoolCreate,
+		Read:   resourceKubevirtVirtualMachinePoolRead,
+		Update: resourceKubevirtVirtualMachinePoolUpdate,
+		Delete: resourceKubevirtVirtualMachinePoolDelete,
+		Exists: resourceKubevirtVirtualMachinePoolExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(40 * time.Minute),
+			Update: schema.DefaultTimeout(40 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: virtualmachinepool.VirtualMachinePoolFields(),
+	}
+}
+
+func resourceKubevirtVirtualMachinePoolCreate(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	pool, err := virtualmachinepool.FromResourceData(resourceData)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating new virtual machine pool %s", pool.Name)
+	if err := cli.CreateVirtualMachinePool(pool); err != nil {
+		return err
+	}
+	log.Printf("[INFO] Submitted new virtual machine pool %s", pool.Name)
+	if err := virtualmachinepool.ToResourceData(*pool, resourceData); err != nil {
+		return err
+	}
+	resourceData.SetId(utils.BuildId(pool.ObjectMeta))
+
+	if err := waitForVirtualMachinePoolReady(cli, pool.Namespace, pool.Name, resourceData.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourceKubevirtVirtualMachinePoolRead(resourceData, meta)
+}
+
+// waitForVirtualMachinePoolReady waits for every replica of the pool to be ready, unless the pool is paused
+// since its controller doesn't reconcile the replicas then. Replicas which aren't started by their run
+// strategy never become ready, those are only waited for to be created.
+func waitForVirtualMachinePoolReady(cli client.Client, namespace, name string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Scaling"},
+		Target:  []string{"Ready"},
+		Timeout: timeout,
+		Refresh: func() (interface{}, string, error) {
+			pool, err := cli.GetVirtualMachinePool(namespace, name)
+			if err != nil {
+				if errors.IsNotFound(err) {
+					log.Printf("[DEBUG] virtual machine pool %s is not created yet", name)
+					return pool, "Scaling", nil
+				}
+				return pool, "", err
+			}
+
+			if pool.Spec.Paused {
+				log.Printf("[DEBUG] virtual machine pool %s is paused, not waiting for its replicas", name)
+				return pool, "Ready", nil
+			}
+			replicas := int32(1)
+			if pool.Spec.Replicas != nil {
+				replicas = *pool.Spec.Replicas
+			}
+			if pool.Status.Replicas == replicas && (pool.Status.ReadyReplicas == replicas || !virtualMachinePoolRunsReplicas(pool)) {
+				return pool, "Ready", nil
+			}
+
+			log.Printf("[DEBUG] virtual machine pool %s has %d ready replicas out of %d, waiting for %d", name, pool.Status.ReadyReplicas, pool.Status.Replicas, replicas)
+			return pool, "Scaling", nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("%s", err)
+	}
+	return nil
+}
+
+// virtualMachinePoolRunsReplicas tells whether the run strategy of the template starts the replicas.
+func virtualMachinePoolRunsReplicas(pool *poolv1alpha1.VirtualMachinePool) bool {
+	if pool.Spec.VirtualMachineTemplate == nil {
+		return true
+	}
+	vm := &kubevirtapiv1.VirtualMachine{Spec: pool.Spec.VirtualMachineTemplate.Spec}
+	runStrategy, err := vm.RunStrategy()
+	if err != nil {
+		return true
+	}
+	return runStrategy != kubevirtapiv1.RunStrategyHalted && runStrategy != kubevirtapiv1.RunStrategyManual
+}
+
+func resourceKubevirtVirtualMachinePoolRead(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading virtual machine pool %s", name)
+
+	pool, err := cli.GetVirtualMachinePool(namespace, name)
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received virtual machine pool %s", pool.Name)
+
+	return virtualmachinepool.ToResourceData(*pool, resourceData)
+}
+
+func resourceKubevirtVirtualMachinePoolUpdate(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	ops, err := virtualmachinepool.AppendPatchOps("", "", resourceData, make([]patch.PatchOperation, 0, 0))
+	if err != nil {
+		return err
+	}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("Failed to marshal update operations: %s", err)
+	}
+
+	log.Printf("[INFO] Updating virtual machine pool: %s", ops)
+	out := &poolv1alpha1.VirtualMachinePool{}
+	if err := cli.UpdateVirtualMachinePool(namespace, name, out, data); err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Submitted updated virtual machine pool %s", out.Name)
+
+	if resourceData.HasChange("spec") {
+		if err := waitForVirtualMachinePoolReady(cli, namespace, name, resourceData.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceKubevirtVirtualMachinePoolRead(resourceData, meta)
+}
+
+func resourceKubevirtVirtualMachinePoolDelete(resourceData *schema.ResourceData, meta interface{}) error {
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	cli := (meta).(client.Client)
+
+	log.Printf("[INFO] Deleting virtual machine pool: %#v", name)
+	if err := cli.DeleteVirtualMachinePool(namespace, name); err != nil {
+		return err
+	}
+
+	// Wait for virtual machine pool to be removed:
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Deleting"},
+		Timeout: resourceData.Timeout(schema.TimeoutDelete),
+		Refresh: func() (interface{}, string, error) {
+			pool, err := cli.GetVirtualMachinePool(namespace, name)
+			if err != nil {
+				if errors.IsNotFound(err) {
+					return nil, "", nil
+				}
+				return pool, "", err
+			}
+
+			log.Printf("[DEBUG] Virtual machine pool %s is being deleted", pool.GetName())
+			return pool, "Deleting", nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("%s", err)
+	}
+
+	log.Printf("[INFO] virtual machine pool %s deleted", name)
+
+	resourceData.SetId("")
+	return nil
+}
+
+func resourceKubevirtVirtualMachinePoolExists(resourceData *schema.ResourceData, meta interface{}) (bool, error) {
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return false, err
+	}
+
+	cli := (meta).(client.Client)
+
+	log.Printf("[INFO] Checking virtual machine pool %s", name)
+	if _, err := cli.GetVirtualMachinePool(namespace, name); err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return true, err
+	}
+	return true, nil
+}
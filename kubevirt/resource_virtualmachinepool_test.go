@@ -0,0 +1,69 @@
+package kubevirt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/client/mock"
+	"gotest.tools/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubevirtapiv1 "kubevirt.io/api/core/v1"
+	poolv1alpha1 "kubevirt.io/api/pool/v1alpha1"
+)
+
+func TestWaitForVirtualMachinePoolReady(t *testing.T) {
+	virtualMachinePool := func(runStrategy kubevirtapiv1.VirtualMachineRunStrategy, readyReplicas int32) *poolv1alpha1.VirtualMachinePool {
+		replicas := int32(2)
+		return &poolv1alpha1.VirtualMachinePool{
+			ObjectMeta: metav1.ObjectMeta{Name: "pool", Namespace: "default"},
+			Spec: poolv1alpha1.VirtualMachinePoolSpec{
+				Replicas: &replicas,
+				VirtualMachineTemplate: &poolv1alpha1.VirtualMachineTemplateSpec{
+					Spec: kubevirtapiv1.VirtualMachineSpec{RunStrategy: &runStrategy},
+				},
+			},
+			Status: poolv1alpha1.VirtualMachinePoolStatus{Replicas: replicas, ReadyReplicas: readyReplicas},
+		}
+	}
+
+	cases := []struct {
+		name  string
+		pools []*poolv1alpha1.VirtualMachinePool
+	}{
+		{
+			name: "running replicas become ready",
+			pools: []*poolv1alpha1.VirtualMachinePool{
+				virtualMachinePool(kubevirtapiv1.RunStrategyAlways, 0),
+				virtualMachinePool(kubevirtapiv1.RunStrategyAlways, 2),
+			},
+		},
+		{
+			name: "halted replicas are only created",
+			pools: []*poolv1alpha1.VirtualMachinePool{
+				virtualMachinePool(kubevirtapiv1.RunStrategyHalted, 0),
+			},
+		},
+		{
+			name: "manual replicas are only created",
+			pools: []*poolv1alpha1.VirtualMachinePool{
+				virtualMachinePool(kubevirtapiv1.RunStrategyManual, 0),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			cli := mock.NewMockClient(ctrl)
+
+			calls := make([]*gomock.Call, len(tc.pools))
+			for i, pool := range tc.pools {
+				calls[i] = cli.EXPECT().GetVirtualMachinePool("default", "pool").Return(pool, nil)
+			}
+			gomock.InOrder(calls...)
+
+			assert.NilError(t, waitForVirtualMachinePoolReady(cli, "default", "pool", time.Minute))
+		})
+	}
+}
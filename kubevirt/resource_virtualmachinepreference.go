@@ -0,0 +1,155 @@
+package kubevirt
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/client"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/instancetype"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils/patch"
+	"k8s.io/apimachinery/pkg/api/errors"
+	instancetypev1alpha2 "kubevirt.io/api/instancetype/v1alpha2"
+)
+
+func resourceKubevirtVirtualMachinePreference() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubevirtVirtualMachinePreferenceCreate,
+		Read:   resourceKubevirtVirtualMachinePreferenceRead,
+		Update: resourceKubevirtVirtualMachinePreferenceUpdate,
+		Delete: resourceKubevirtVirtualMachinePreferenceDelete,
+		Exists: resourceKubevirtVirtualMachinePreferenceExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: instancetype.PreferenceFields("VirtualMachinePreference", true),
+	}
+}
+
+func resourceKubevirtVirtualMachinePreferenceCreate(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	objectMeta, spec := instancetype.PreferenceFromResourceData(resourceData)
+	preference := &instancetypev1alpha2.VirtualMachinePreference{ObjectMeta: objectMeta, Spec: spec}
+
+	log.Printf("[INFO] Creating new virtual machine preference: %#v", preference)
+	if err := cli.CreateVirtualMachinePreference(preference); err != nil {
+		return err
+	}
+	log.Printf("[INFO] Submitted new virtual machine preference: %#v", preference)
+
+	resourceData.SetId(utils.BuildId(preference.ObjectMeta))
+
+	return resourceKubevirtVirtualMachinePreferenceRead(resourceData, meta)
+}
+
+func resourceKubevirtVirtualMachinePreferenceRead(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading virtual machine preference %s", name)
+
+	preference, err := cli.GetVirtualMachinePreference(namespace, name)
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received virtual machine preference: %#v", preference)
+
+	return instancetype.PreferenceToResourceData(preference.ObjectMeta, preference.Spec, resourceData)
+}
+
+func resourceKubevirtVirtualMachinePreferenceUpdate(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	ops := instancetype.PreferenceAppendPatchOps("", "", resourceData, make([]patch.PatchOperation, 0, 0))
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("Failed to marshal update operations: %s", err)
+	}
+
+	log.Printf("[INFO] Updating virtual machine preference: %s", ops)
+	out := &instancetypev1alpha2.VirtualMachinePreference{}
+	if err := cli.UpdateVirtualMachinePreference(namespace, name, out, data); err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Submitted updated virtual machine preference: %#v", out)
+
+	return resourceKubevirtVirtualMachinePreferenceRead(resourceData, meta)
+}
+
+func resourceKubevirtVirtualMachinePreferenceDelete(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting virtual machine preference: %#v", name)
+	if err := cli.DeleteVirtualMachinePreference(namespace, name); err != nil {
+		return err
+	}
+
+	// Wait for virtual machine preference to be removed:
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Deleting"},
+		Timeout: resourceData.Timeout(schema.TimeoutDelete),
+		Refresh: func() (interface{}, string, error) {
+			preference, err := cli.GetVirtualMachinePreference(namespace, name)
+			if err != nil {
+				if errors.IsNotFound(err) {
+					return nil, "", nil
+				}
+				return preference, "", err
+			}
+
+			log.Printf("[DEBUG] Virtual machine preference %s is being deleted", preference.GetName())
+			return preference, "Deleting", nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("%s", err)
+	}
+
+	log.Printf("[INFO] virtual machine preference %s deleted", name)
+
+	resourceData.SetId("")
+	return nil
+}
+
+func resourceKubevirtVirtualMachinePreferenceExists(resourceData *schema.ResourceData, meta interface{}) (bool, error) {
+	cli := (meta).(client.Client)
+
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return false, err
+	}
+
+	log.Printf("[INFO] Checking virtual machine preference %s", name)
+	if _, err := cli.GetVirtualMachinePreference(namespace, name); err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return true, err
+	}
+	return true, nil
+}
@@ -0,0 +1,193 @@
+package kubevirt
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/client"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/virtualmachine"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/virtualmachinerestore"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	snapshotv1alpha1 "kubevirt.io/api/snapshot/v1alpha1"
+)
+
+func resourceKubevirtVirtualMachineRestore() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubevirtVirtualMachineRestoreCreate,
+		Read:   resourceKubevirtVirtualMachineRestoreRead,
+		// Only the power fields can change in place, they only matter while restoring.
+		Update: resourceKubevirtVirtualMachineRestoreRead,
+		Delete: resourceKubevirtVirtualMachineRestoreDelete,
+		Exists: resourceKubevirtVirtualMachineRestoreExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(40 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: virtualmachinerestore.VirtualMachineRestoreFields(),
+	}
+}
+
+func resourceKubevirtVirtualMachineRestoreCreate(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	restore := virtualmachinerestore.FromResourceData(resourceData)
+
+	name := restore.ObjectMeta.Name
+	namespace := restore.ObjectMeta.Namespace
+	target := restore.Spec.Target.Name
+	timeout := resourceData.Timeout(schema.TimeoutCreate)
+
+	if resourceData.Get("stop_before_restore").(bool) {
+		log.Printf("[INFO] Stopping virtual machine %s before restoring it", target)
+		if err := setVirtualMachinePowerState(cli, namespace, target, virtualmachine.PowerStateStopped, timeout); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[INFO] Creating new virtual machine restore: %#v", restore)
+	if err := cli.CreateVirtualMachineRestore(restore); err != nil {
+		return err
+	}
+	log.Printf("[INFO] Submitted new virtual machine restore: %#v", restore)
+	if err := virtualmachinerestore.ToResourceData(*restore, resourceData); err != nil {
+		return err
+	}
+	resourceData.SetId(utils.BuildId(restore.ObjectMeta))
+
+	// Wait for the restore to complete:
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Restoring"},
+		Target:  []string{"Complete"},
+		Timeout: timeout,
+		Refresh: func() (interface{}, string, error) {
+			var err error
+			restore, err = cli.GetVirtualMachineRestore(namespace, name)
+			if err != nil {
+				if errors.IsNotFound(err) {
+					log.Printf("[DEBUG] virtual machine restore %s is not created yet", name)
+					return restore, "Restoring", nil
+				}
+				return restore, "", err
+			}
+
+			status := restore.Status
+			if status == nil {
+				return restore, "Restoring", nil
+			}
+			if status.Complete != nil && *status.Complete {
+				return restore, "Complete", nil
+			}
+			for _, condition := range status.Conditions {
+				if condition.Type == snapshotv1alpha1.ConditionFailure && condition.Status == k8sv1.ConditionTrue {
+					return restore, "", fmt.Errorf("virtual machine restore %s of %s failed: %s", name, target, condition.Message)
+				}
+			}
+
+			log.Printf("[DEBUG] virtual machine restore %s is not complete yet", name)
+			return restore, "Restoring", nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("%s", err)
+	}
+
+	if resourceData.Get("start_after_restore").(bool) {
+		log.Printf("[INFO] Starting virtual machine %s after restoring it", target)
+		if err := setVirtualMachinePowerState(cli, namespace, target, virtualmachine.PowerStateRunning, timeout); err != nil {
+			return err
+		}
+	}
+
+	return resourceKubevirtVirtualMachineRestoreRead(resourceData, meta)
+}
+
+func resourceKubevirtVirtualMachineRestoreRead(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading virtual machine restore %s", name)
+
+	restore, err := cli.GetVirtualMachineRestore(namespace, name)
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received virtual machine restore: %#v", restore)
+
+	return virtualmachinerestore.ToResourceData(*restore, resourceData)
+}
+
+func resourceKubevirtVirtualMachineRestoreDelete(resourceData *schema.ResourceData, meta interface{}) error {
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	cli := (meta).(client.Client)
+
+	log.Printf("[INFO] Deleting virtual machine restore: %#v", name)
+	if err := cli.DeleteVirtualMachineRestore(namespace, name); err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	// Wait for virtual machine restore to be removed:
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Deleting"},
+		Timeout: resourceData.Timeout(schema.TimeoutDelete),
+		Refresh: func() (interface{}, string, error) {
+			restore, err := cli.GetVirtualMachineRestore(namespace, name)
+			if err != nil {
+				if errors.IsNotFound(err) {
+					return nil, "", nil
+				}
+				return restore, "", err
+			}
+
+			log.Printf("[DEBUG] Virtual machine restore %s is being deleted", restore.GetName())
+			return restore, "Deleting", nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("%s", err)
+	}
+
+	log.Printf("[INFO] virtual machine restore %s deleted", name)
+
+	resourceData.SetId("")
+	return nil
+}
+
+func resourceKubevirtVirtualMachineRestoreExists(resourceData *schema.ResourceData, meta interface{}) (bool, error) {
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return false, err
+	}
+
+	cli := (meta).(client.Client)
+
+	log.Printf("[INFO] Checking virtual machine restore %s", name)
+	if _, err := cli.GetVirtualMachineRestore(namespace, name); err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return true, err
+	}
+	return true, nil
+}
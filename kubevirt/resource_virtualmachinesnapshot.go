@@ -0,0 +1,214 @@
+package kubevirt
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/client"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/virtualmachinesnapshot"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils/patch"
+	"k8s.io/apimachinery/pkg/api/errors"
+	snapshotv1alpha1 "kubevirt.io/api/snapshot/v1alpha1"
+)
+
+func resourceKubevirtVirtualMachineSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubevirtVirtualMachineSnapshotCreate,
+		Read:   resourceKubevirtVirtualMachineSnapshotRead,
+		Update: resourceKubevirtVirtualMachineSnapshotUpdate,
+		Delete: resourceKubevirtVirtualMachineSnapshotDelete,
+		Exists: resourceKubevirtVirtualMachineSnapshotExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(40 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: virtualmachinesnapshot.VirtualMachineSnapshotFields(),
+	}
+}
+
+func resourceKubevirtVirtualMachineSnapshotCreate(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	snapshot, err := virtualmachinesnapshot.FromResourceData(resourceData)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating new virtual machine snapshot: %#v", snapshot)
+	if err := cli.CreateVirtualMachineSnapshot(snapshot); err != nil {
+		return err
+	}
+	log.Printf("[INFO] Submitted new virtual machine snapshot: %#v", snapshot)
+	if err := virtualmachinesnapshot.ToResourceData(*snapshot, nil, resourceData); err != nil {
+		return err
+	}
+	resourceData.SetId(utils.BuildId(snapshot.ObjectMeta))
+
+	// Wait for the snapshot to be ready to use:
+	name := snapshot.ObjectMeta.Name
+	namespace := snapshot.ObjectMeta.Namespace
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"InProgress"},
+		Target:  []string{"ReadyToUse"},
+		Timeout: resourceData.Timeout(schema.TimeoutCreate),
+		Refresh: func() (interface{}, string, error) {
+			var err error
+			snapshot, err = cli.GetVirtualMachineSnapshot(namespace, name)
+			if err != nil {
+				if errors.IsNotFound(err) {
+					log.Printf("[DEBUG] virtual machine snapshot %s is not created yet", name)
+					return snapshot, "InProgress", nil
+				}
+				return snapshot, "", err
+			}
+
+			status := snapshot.Status
+			if status == nil {
+				return snapshot, "InProgress", nil
+			}
+			if status.ReadyToUse != nil && *status.ReadyToUse {
+				return snapshot, "ReadyToUse", nil
+			}
+			if status.Phase == snapshotv1alpha1.Failed {
+				msg := fmt.Sprintf("virtual machine snapshot %s failed, finished with phase=\"failed\"", name)
+				if status.Error != nil && status.Error.Message != nil {
+					msg += ": " + *status.Error.Message
+				}
+				return snapshot, "", fmt.Errorf(msg)
+			}
+
+			log.Printf("[DEBUG] virtual machine snapshot %s is in phase %q", name, status.Phase)
+			return snapshot, "InProgress", nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("%s", err)
+	}
+
+	return resourceKubevirtVirtualMachineSnapshotRead(resourceData, meta)
+}
+
+func resourceKubevirtVirtualMachineSnapshotRead(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading virtual machine snapshot %s", name)
+
+	snapshot, err := cli.GetVirtualMachineSnapshot(namespace, name)
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received virtual machine snapshot: %#v", snapshot)
+
+	var content *snapshotv1alpha1.VirtualMachineSnapshotContent
+	if status := snapshot.Status; status != nil && status.VirtualMachineSnapshotContentName != nil {
+		content, err = cli.GetVirtualMachineSnapshotContent(namespace, *status.VirtualMachineSnapshotContentName)
+		if err != nil {
+			if !errors.IsNotFound(err) {
+				return err
+			}
+			content = nil
+		}
+	}
+
+	return virtualmachinesnapshot.ToResourceData(*snapshot, content, resourceData)
+}
+
+func resourceKubevirtVirtualMachineSnapshotUpdate(resourceData *schema.ResourceData, meta interface{}) error {
+	cli := (meta).(client.Client)
+
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	ops := virtualmachinesnapshot.AppendPatchOps("", "", resourceData, make([]patch.PatchOperation, 0, 0))
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("Failed to marshal update operations: %s", err)
+	}
+
+	log.Printf("[INFO] Updating virtual machine snapshot: %s", ops)
+	out := &snapshotv1alpha1.VirtualMachineSnapshot{}
+	if err := cli.UpdateVirtualMachineSnapshot(namespace, name, out, data); err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Submitted updated virtual machine snapshot: %#v", out)
+
+	return resourceKubevirtVirtualMachineSnapshotRead(resourceData, meta)
+}
+
+func resourceKubevirtVirtualMachineSnapshotDelete(resourceData *schema.ResourceData, meta interface{}) error {
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	cli := (meta).(client.Client)
+
+	log.Printf("[INFO] Deleting virtual machine snapshot: %#v", name)
+	if err := cli.DeleteVirtualMachineSnapshot(namespace, name); err != nil {
+		return err
+	}
+
+	// Wait for virtual machine snapshot to be removed:
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Deleting"},
+		Timeout: resourceData.Timeout(schema.TimeoutDelete),
+		Refresh: func() (interface{}, string, error) {
+			snapshot, err := cli.GetVirtualMachineSnapshot(namespace, name)
+			if err != nil {
+				if errors.IsNotFound(err) {
+					return nil, "", nil
+				}
+				return snapshot, "", err
+			}
+
+			log.Printf("[DEBUG] Virtual machine snapshot %s is being deleted", snapshot.GetName())
+			return snapshot, "Deleting", nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("%s", err)
+	}
+
+	log.Printf("[INFO] virtual machine snapshot %s deleted", name)
+
+	resourceData.SetId("")
+	return nil
+}
+
+func resourceKubevirtVirtualMachineSnapshotExists(resourceData *schema.ResourceData, meta interface{}) (bool, error) {
+	namespace, name, err := utils.IdParts(resourceData.Id())
+	if err != nil {
+		return false, err
+	}
+
+	cli := (meta).(client.Client)
+
+	log.Printf("[INFO] Checking virtual machine snapshot %s", name)
+	if _, err := cli.GetVirtualMachineSnapshot(namespace, name); err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return true, err
+	}
+	return true, nil
+}
@@ -0,0 +1,297 @@
+package dataimportcron
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/datavolume"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/k8s"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils/patch"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+)
+
+func DataImportCronFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"metadata": k8s.NamespacedMetadataSchema("DataImportCron", false),
+		"spec":     dataImportCronSpecSchema(),
+		"status":   dataImportCronStatusSchema(),
+	}
+}
+
+func dataImportCronSpecFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"template": {
+			Type:        schema.TypeList,
+			Description: "Template of the DataVolume importing the source, its source is usually a registry.",
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"spec": datavolume.DataVolumeSpecSchemaAt("spec.0.template.0.spec"),
+				},
+			},
+		},
+		"schedule": {
+			Type:        schema.TypeString,
+			Description: "Cron schedule polling the source for updates, e.g. \"0 */12 * * *\".",
+			Required:    true,
+		},
+		"garbage_collect": {
+			Type:        schema.TypeString,
+			Description: "Whether outdated imports are garbage collected: \"Outdated\" (default) or \"Never\".",
+			Optional:    true,
+			Computed:    true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(cdiv1.DataImportCronGarbageCollectOutdated),
+				string(cdiv1.DataImportCronGarbageCollectNever),
+			}, false),
+		},
+		"imports_to_keep": {
+			Type:         schema.TypeInt,
+			Description:  "Number of imports kept by the garbage collection.",
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"managed_data_source": {
+			Type:         schema.TypeString,
+			Description:  "Name of the DataSource pointing to the last import, it is created if it doesn't exist.",
+			Required:     true,
+			ValidateFunc: utils.ValidateName,
+		},
+		"retention_policy": {
+			Type:        schema.TypeString,
+			Description: "Whether the imports are kept once the DataImportCron is deleted: \"None\" or \"All\".",
+			Optional:    true,
+			Computed:    true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(cdiv1.DataImportCronRetainNone),
+				string(cdiv1.DataImportCronRetainAll),
+			}, false),
+		},
+	}
+}
+
+func dataImportCronSpecSchema() *schema.Schema {
+	fields := dataImportCronSpecFields()
+
+	return &schema.Schema{
+		Type: schema.TypeList,
+
+		Description: fmt.Sprintf("DataImportCronSpec defines the source, schedule and retention of the imports."),
+		Required:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+func dataImportCronStatusFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"last_imported_pvc": {
+			Type:        schema.TypeList,
+			Description: "PersistentVolumeClaim of the last successful import.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"namespace": {
+						Type:        schema.TypeString,
+						Description: "Namespace of the PersistentVolumeClaim.",
+						Computed:    true,
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Description: "Name of the PersistentVolumeClaim.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"last_import_timestamp": {
+			Type:        schema.TypeString,
+			Description: "Time of the last successful import.",
+			Computed:    true,
+		},
+		"last_execution_timestamp": {
+			Type:        schema.TypeString,
+			Description: "Time of the last poll of the source.",
+			Computed:    true,
+		},
+		"current_imports": {
+			Type:        schema.TypeList,
+			Description: "Imports in progress.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"data_volume_name": {
+						Type:        schema.TypeString,
+						Description: "Name of the DataVolume importing the source.",
+						Computed:    true,
+					},
+					"digest": {
+						Type:        schema.TypeString,
+						Description: "Digest of the imported image.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"conditions": k8s.ConditionsSchema(),
+	}
+}
+
+func dataImportCronStatusSchema() *schema.Schema {
+	fields := dataImportCronStatusFields()
+
+	return &schema.Schema{
+		Type: schema.TypeList,
+
+		Description: fmt.Sprintf("DataImportCronStatus provides the most recently observed status of the DataImportCron."),
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+func expandDataImportCronSpec(dataImportCronSpec []interface{}) (cdiv1.DataImportCronSpec, error) {
+	result := cdiv1.DataImportCronSpec{}
+
+	if len(dataImportCronSpec) == 0 || dataImportCronSpec[0] == nil {
+		return result, nil
+	}
+
+	in := dataImportCronSpec[0].(map[string]interface{})
+
+	if v, ok := in["template"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		template := v[0].(map[string]interface{})
+		spec, err := datavolume.ExpandDataVolumeSpec(template["spec"].([]interface{}))
+		if err != nil {
+			return result, err
+		}
+		result.Template.Spec = spec
+	}
+	if v, ok := in["schedule"].(string); ok {
+		result.Schedule = v
+	}
+	if v, ok := in["garbage_collect"].(string); ok && v != "" {
+		garbageCollect := cdiv1.DataImportCronGarbageCollect(v)
+		result.GarbageCollect = &garbageCollect
+	}
+	if v, ok := in["imports_to_keep"].(int); ok && v > 0 {
+		importsToKeep := int32(v)
+		result.ImportsToKeep = &importsToKeep
+	}
+	if v, ok := in["managed_data_source"].(string); ok {
+		result.ManagedDataSource = v
+	}
+	if v, ok := in["retention_policy"].(string); ok && v != "" {
+		retentionPolicy := cdiv1.DataImportCronRetentionPolicy(v)
+		result.RetentionPolicy = &retentionPolicy
+	}
+
+	return result, nil
+}
+
+func flattenDataImportCronSpec(in cdiv1.DataImportCronSpec) []interface{} {
+	att := make(map[string]interface{})
+
+	att["template"] = []interface{}{map[string]interface{}{
+		"spec": datavolume.FlattenDataVolumeSpec(in.Template.Spec),
+	}}
+	att["schedule"] = in.Schedule
+	if in.GarbageCollect != nil {
+		att["garbage_collect"] = string(*in.GarbageCollect)
+	}
+	if in.ImportsToKeep != nil {
+		att["imports_to_keep"] = int(*in.ImportsToKeep)
+	}
+	att["managed_data_source"] = in.ManagedDataSource
+	if in.RetentionPolicy != nil {
+		att["retention_policy"] = string(*in.RetentionPolicy)
+	}
+
+	return []interface{}{att}
+}
+
+func flattenDataImportCronStatus(in cdiv1.DataImportCronStatus) []interface{} {
+	att := make(map[string]interface{})
+
+	if in.LastImportedPVC != nil {
+		att["last_imported_pvc"] = []interface{}{map[string]interface{}{
+			"namespace": in.LastImportedPVC.Namespace,
+			"name":      in.LastImportedPVC.Name,
+		}}
+	}
+	att["last_import_timestamp"] = utils.FlattenTime(in.LastImportTimestamp)
+	att["last_execution_timestamp"] = utils.FlattenTime(in.LastExecutionTimestamp)
+
+	currentImports := make([]interface{}, len(in.CurrentImports))
+	for i, v := range in.CurrentImports {
+		currentImports[i] = map[string]interface{}{
+			"data_volume_name": v.DataVolumeName,
+			"digest":           v.Digest,
+		}
+	}
+	att["current_imports"] = currentImports
+
+	conditions := make([]interface{}, len(in.Conditions))
+	for i, v := range in.Conditions {
+		conditions[i] = map[string]interface{}{
+			"type":    string(v.Type),
+			"status":  string(v.Status),
+			"reason":  v.Reason,
+			"message": v.Message,
+		}
+	}
+	att["conditions"] = conditions
+
+	return []interface{}{att}
+}
+
+func FromResourceData(resourceData *schema.ResourceData) (*cdiv1.DataImportCron, error) {
+	result := &cdiv1.DataImportCron{}
+
+	result.ObjectMeta = k8s.ExpandMetadata(resourceData.Get("metadata").([]interface{}))
+	spec, err := expandDataImportCronSpec(resourceData.Get("spec").([]interface{}))
+	if err != nil {
+		return result, err
+	}
+	result.Spec = spec
+
+	return result, nil
+}
+
+func ToResourceData(cron cdiv1.DataImportCron, resourceData *schema.ResourceData) error {
+	if err := resourceData.Set("metadata", k8s.FlattenMetadata(cron.ObjectMeta)); err != nil {
+		return err
+	}
+	if err := resourceData.Set("spec", flattenDataImportCronSpec(cron.Spec)); err != nil {
+		return err
+	}
+	if err := resourceData.Set("status", flattenDataImportCronStatus(cron.Status)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func AppendPatchOps(keyPrefix, pathPrefix string, resourceData *schema.ResourceData, ops []patch.PatchOperation) (patch.PatchOperations, error) {
+	ops = k8s.AppendPatchOps(keyPrefix+"metadata.0.", pathPrefix+"/metadata/", resourceData, ops)
+
+	if resourceData.HasChange(keyPrefix + "spec") {
+		spec, err := expandDataImportCronSpec(resourceData.Get(keyPrefix + "spec").([]interface{}))
+		if err != nil {
+			return ops, err
+		}
+		ops = append(ops, &patch.ReplaceOperation{
+			Path:  pathPrefix + "/spec",
+			Value: spec,
+		})
+	}
+
+	return ops, nil
+}
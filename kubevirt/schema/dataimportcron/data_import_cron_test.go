@@ -0,0 +1,72 @@
+package dataimportcron
+
+import (
+	"testing"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"gotest.tools/assert"
+)
+
+func TestExpandFlattenDataImportCronSpec(t *testing.T) {
+	url := "docker://quay.io/containerdisks/fedora:latest"
+	pullMethod := cdiv1.RegistryPullNode
+	garbageCollect := cdiv1.DataImportCronGarbageCollectOutdated
+	importsToKeep := int32(2)
+
+	spec := cdiv1.DataImportCronSpec{
+		Template: cdiv1.DataVolume{
+			Spec: cdiv1.DataVolumeSpec{
+				Source: &cdiv1.DataVolumeSource{
+					Registry: &cdiv1.DataVolumeSourceRegistry{
+						URL:        &url,
+						PullMethod: &pullMethod,
+					},
+				},
+				Storage: &cdiv1.StorageSpec{
+					Resources: k8sv1.ResourceRequirements{
+						Requests: k8sv1.ResourceList{
+							k8sv1.ResourceStorage: resource.MustParse("5Gi"),
+						},
+					},
+				},
+			},
+		},
+		Schedule:          "0 2 * * *",
+		GarbageCollect:    &garbageCollect,
+		ImportsToKeep:     &importsToKeep,
+		ManagedDataSource: "fedora",
+	}
+
+	flattened := flattenDataImportCronSpec(spec)
+	assert.Equal(t, flattened[0].(map[string]interface{})["managed_data_source"], "fedora")
+
+	expanded, err := expandDataImportCronSpec(flattened)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, expanded, spec)
+}
+
+func TestFlattenDataImportCronStatus(t *testing.T) {
+	imported := metav1.NewTime(time.Date(2023, 5, 4, 2, 0, 0, 0, time.UTC))
+
+	status := cdiv1.DataImportCronStatus{
+		LastImportedPVC:     &cdiv1.DataVolumeSourcePVC{Namespace: "golden-images", Name: "fedora-3f1c2a"},
+		LastImportTimestamp: &imported,
+	}
+
+	assert.DeepEqual(t, flattenDataImportCronStatus(status), []interface{}{
+		map[string]interface{}{
+			"last_imported_pvc": []interface{}{
+				map[string]interface{}{"namespace": "golden-images", "name": "fedora-3f1c2a"},
+			},
+			"last_import_timestamp":    "2023-05-04T02:00:00Z",
+			"last_execution_timestamp": "",
+			"current_imports":          []interface{}{},
+			"conditions":               []interface{}{},
+		},
+	})
+}
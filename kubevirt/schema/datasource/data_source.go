@@ -0,0 +1,218 @@
+package datasource
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/k8s"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils/patch"
+	k8sv1 "k8s.io/api/core/v1"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+)
+
+func DataSourceFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"metadata": k8s.NamespacedMetadataSchema("DataSource", false),
+		"spec":     dataSourceSpecSchema(),
+		"status":   dataSourceStatusSchema(),
+	}
+}
+
+func dataSourceSpecFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"source": {
+			Type:        schema.TypeList,
+			Description: "Source of the data the DataSource points to.",
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: dataSourceSourceFields(),
+			},
+		},
+	}
+}
+
+func dataSourceSpecSchema() *schema.Schema {
+	fields := dataSourceSpecFields()
+
+	return &schema.Schema{
+		Type: schema.TypeList,
+
+		Description: fmt.Sprintf("DataSourceSpec defines the source of a DataSource."),
+		Required:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+func dataSourceSourceFields() map[string]*schema.Schema {
+	sources := []string{"spec.0.source.0.pvc", "spec.0.source.0.snapshot"}
+
+	pvc := objectReferenceSchema("PersistentVolumeClaim")
+	pvc.ExactlyOneOf = sources
+	snapshot := objectReferenceSchema("VolumeSnapshot")
+	snapshot.ExactlyOneOf = sources
+
+	return map[string]*schema.Schema{
+		"pvc":      pvc,
+		"snapshot": snapshot,
+	}
+}
+
+func objectReferenceSchema(kind string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: fmt.Sprintf("%s the DataSource points to.", kind),
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"namespace": {
+					Type:        schema.TypeString,
+					Description: fmt.Sprintf("Namespace of the %s, defaults to the namespace of the DataSource.", kind),
+					Optional:    true,
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Description: fmt.Sprintf("Name of the %s.", kind),
+					Required:    true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceStatusFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"ready": {
+			Type:        schema.TypeBool,
+			Description: "Whether the source of the DataSource is ready to be consumed.",
+			Computed:    true,
+		},
+		"conditions": k8s.ConditionsSchema(),
+	}
+}
+
+func dataSourceStatusSchema() *schema.Schema {
+	fields := dataSourceStatusFields()
+
+	return &schema.Schema{
+		Type: schema.TypeList,
+
+		Description: fmt.Sprintf("DataSourceStatus provides the most recently observed status of the DataSource."),
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+func expandDataSourceSpec(dataSourceSpec []interface{}) cdiv1.DataSourceSpec {
+	result := cdiv1.DataSourceSpec{}
+
+	if len(dataSourceSpec) == 0 || dataSourceSpec[0] == nil {
+		return result
+	}
+
+	in := dataSourceSpec[0].(map[string]interface{})
+
+	if v, ok := in["source"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		source := v[0].(map[string]interface{})
+		if v, ok := source["pvc"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			pvc := v[0].(map[string]interface{})
+			result.Source.PVC = &cdiv1.DataVolumeSourcePVC{
+				Namespace: pvc["namespace"].(string),
+				Name:      pvc["name"].(string),
+			}
+		}
+		if v, ok := source["snapshot"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			snapshot := v[0].(map[string]interface{})
+			result.Source.Snapshot = &cdiv1.DataVolumeSourceSnapshot{
+				Namespace: snapshot["namespace"].(string),
+				Name:      snapshot["name"].(string),
+			}
+		}
+	}
+
+	return result
+}
+
+func flattenDataSourceSpec(in cdiv1.DataSourceSpec) []interface{} {
+	source := make(map[string]interface{})
+
+	if in.Source.PVC != nil {
+		source["pvc"] = []interface{}{map[string]interface{}{
+			"namespace": in.Source.PVC.Namespace,
+			"name":      in.Source.PVC.Name,
+		}}
+	}
+	if in.Source.Snapshot != nil {
+		source["snapshot"] = []interface{}{map[string]interface{}{
+			"namespace": in.Source.Snapshot.Namespace,
+			"name":      in.Source.Snapshot.Name,
+		}}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"source": []interface{}{source},
+	}}
+}
+
+func flattenDataSourceStatus(in cdiv1.DataSourceStatus) []interface{} {
+	att := make(map[string]interface{})
+
+	att["ready"] = false
+	conditions := make([]interface{}, len(in.Conditions))
+	for i, v := range in.Conditions {
+		if v.Type == cdiv1.DataSourceReady {
+			att["ready"] = v.Status == k8sv1.ConditionTrue
+		}
+		conditions[i] = map[string]interface{}{
+			"type":    string(v.Type),
+			"status":  string(v.Status),
+			"reason":  v.Reason,
+			"message": v.Message,
+		}
+	}
+	att["conditions"] = conditions
+
+	return []interface{}{att}
+}
+
+func FromResourceData(resourceData *schema.ResourceData) *cdiv1.DataSource {
+	result := &cdiv1.DataSource{}
+
+	result.ObjectMeta = k8s.ExpandMetadata(resourceData.Get("metadata").([]interface{}))
+	result.Spec = expandDataSourceSpec(resourceData.Get("spec").([]interface{}))
+
+	return result
+}
+
+func ToResourceData(dataSource cdiv1.DataSource, resourceData *schema.ResourceData) error {
+	if err := resourceData.Set("metadata", k8s.FlattenMetadata(dataSource.ObjectMeta)); err != nil {
+		return err
+	}
+	if err := resourceData.Set("spec", flattenDataSourceSpec(dataSource.Spec)); err != nil {
+		return err
+	}
+	if err := resourceData.Set("status", flattenDataSourceStatus(dataSource.Status)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func AppendPatchOps(keyPrefix, pathPrefix string, resourceData *schema.ResourceData, ops []patch.PatchOperation) patch.PatchOperations {
+	ops = k8s.AppendPatchOps(keyPrefix+"metadata.0.", pathPrefix+"/metadata/", resourceData, ops)
+
+	if resourceData.HasChange(keyPrefix + "spec") {
+		ops = append(ops, &patch.ReplaceOperation{
+			Path:  pathPrefix + "/spec",
+			Value: expandDataSourceSpec(resourceData.Get(keyPrefix + "spec").([]interface{})),
+		})
+	}
+
+	return ops
+}
@@ -0,0 +1,68 @@
+package datasource
+
+import (
+	"testing"
+
+	k8sv1 "k8s.io/api/core/v1"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"gotest.tools/assert"
+)
+
+func TestExpandFlattenDataSourceSpec(t *testing.T) {
+	cases := []struct {
+		name string
+		spec cdiv1.DataSourceSpec
+	}{
+		{
+			name: "pvc source",
+			spec: cdiv1.DataSourceSpec{
+				Source: cdiv1.DataSourceSource{
+					PVC: &cdiv1.DataVolumeSourcePVC{Namespace: "golden-images", Name: "fedora-38"},
+				},
+			},
+		},
+		{
+			name: "snapshot source",
+			spec: cdiv1.DataSourceSpec{
+				Source: cdiv1.DataSourceSource{
+					Snapshot: &cdiv1.DataVolumeSourceSnapshot{Name: "fedora-38-snapshot"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.DeepEqual(t, expandDataSourceSpec(flattenDataSourceSpec(tc.spec)), tc.spec)
+		})
+	}
+}
+
+func TestFlattenDataSourceStatus(t *testing.T) {
+	status := cdiv1.DataSourceStatus{
+		Conditions: []cdiv1.DataSourceCondition{
+			{
+				Type: cdiv1.DataSourceReady,
+				ConditionState: cdiv1.ConditionState{
+					Status: k8sv1.ConditionTrue,
+					Reason: "Ready",
+				},
+			},
+		},
+	}
+
+	assert.DeepEqual(t, flattenDataSourceStatus(status), []interface{}{
+		map[string]interface{}{
+			"ready": true,
+			"conditions": []interface{}{
+				map[string]interface{}{
+					"type":    "Ready",
+					"status":  "True",
+					"reason":  "Ready",
+					"message": "",
+				},
+			},
+		},
+	})
+}
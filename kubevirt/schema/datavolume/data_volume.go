@@ -1,20 +1,47 @@
 package datavolume
 
 import (
+	"context"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/k8s"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils"
 	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils/patch"
+	api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 )
 
 func DataVolumeFields() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
-		"metadata": k8s.NamespacedMetadataSchema("DataVolume", false),
-		"spec":     DataVolumeSpecSchema(),
-		"status":   dataVolumeStatusSchema(),
+		"metadata":      k8s.NamespacedMetadataSchema("DataVolume", false),
+		"spec":          dataVolumeImmutableSpecSchema(),
+		"status":        dataVolumeStatusSchema(),
+		"upload":        dataVolumeUploadSchema(),
+		"upload_sha256": dataVolumeUploadSHA256Schema(),
 	}
 }
 
+// dataVolumeImmutableSpecSchema is the spec of a standalone DataVolume. CDI
+// only lets the storage request of an existing DataVolume expand, so a change
+// of anything else forces a new one; DataVolume templates share the rest of the
+// spec and are patched in place along with their owner instead.
+func dataVolumeImmutableSpecSchema() *schema.Schema {
+	spec := DataVolumeSpecSchemaAt("spec")
+	fields := utils.ForceNewSchema(spec.Elem.(*schema.Resource).Schema)
+
+	for _, claim := range []string{"pvc", "storage"} {
+		resources := fields[claim].Elem.(*schema.Resource).Schema["resources"]
+		resources.ForceNew = false
+		resources.Elem.(*schema.Resource).Schema["requests"].ForceNew = false
+	}
+	spec.Elem = &schema.Resource{
+		Schema: fields,
+	}
+
+	return spec
+}
+
 func ExpandDataVolumeTemplates(dataVolumes []interface{}) ([]cdiv1.DataVolume, error) {
 	result := make([]cdiv1.DataVolume, len(dataVolumes))
 
@@ -88,3 +115,89 @@ func ToResourceData(dv cdiv1.DataVolume, resourceData *schema.ResourceData) erro
 func AppendPatchOps(keyPrefix, pathPrefix string, resourceData *schema.ResourceData, ops []patch.PatchOperation) patch.PatchOperations {
 	return k8s.AppendPatchOps(keyPrefix+"metadata.0.", pathPrefix+"/metadata/", resourceData, ops)
 }
+
+// AppendClaimPatchOps returns the operations expanding the PVC bound to the
+// DataVolume. CDI rejects any change to the spec of an existing DataVolume,
+// so a bigger storage request has to be applied to the claim itself.
+func AppendClaimPatchOps(keyPrefix, pathPrefix string, resourceData *schema.ResourceData, ops []patch.PatchOperation) patch.PatchOperations {
+	for _, key := range storageRequestKeys(keyPrefix) {
+		if resourceData.HasChange(key) {
+			oldV, newV := resourceData.GetChange(key)
+			diffOps := patch.DiffStringMap(pathPrefix+"/spec/resources/requests", oldV.(map[string]interface{}), newV.(map[string]interface{}))
+			ops = append(ops, diffOps...)
+		}
+	}
+	return ops
+}
+
+// StorageRequestKeys returns the keys holding the requested storage of the DataVolume.
+func StorageRequestKeys() []string {
+	return storageRequestKeys("")
+}
+
+func storageRequestKeys(keyPrefix string) []string {
+	return []string{
+		keyPrefix + "spec.0.pvc.0.resources.0.requests",
+		keyPrefix + "spec.0.storage.0.resources.0.requests",
+	}
+}
+
+// StorageRequestDecreased reports whether the requested storage shrinks,
+// which a bound PVC doesn't allow.
+func StorageRequestDecreased(ctx context.Context, oldV, newV, meta interface{}) bool {
+	oldSize, ok := storageRequest(oldV)
+	if !ok {
+		return false
+	}
+	newSize, ok := storageRequest(newV)
+	if !ok {
+		return false
+	}
+	return newSize.Cmp(oldSize) < 0
+}
+
+// SyncClaimStorageRequest keeps the storage request recorded in the resource
+// data when the bound PVC has already been expanded to it, since the spec of
+// the DataVolume keeps reporting the size it was created with.
+func SyncClaimStorageRequest(dv *cdiv1.DataVolume, pvc *api.PersistentVolumeClaim, resourceData *schema.ResourceData) {
+	claimed, ok := pvc.Spec.Resources.Requests[api.ResourceStorage]
+	if !ok {
+		return
+	}
+
+	var requests api.ResourceList
+	var key string
+	if dv.Spec.PVC != nil {
+		requests, key = dv.Spec.PVC.Resources.Requests, storageRequestKeys("")[0]
+	} else if dv.Spec.Storage != nil {
+		requests, key = dv.Spec.Storage.Resources.Requests, storageRequestKeys("")[1]
+	}
+	if requests == nil {
+		return
+	}
+
+	desired, ok := storageRequest(resourceData.Get(key))
+	if !ok {
+		return
+	}
+	current := requests[api.ResourceStorage]
+	if desired.Cmp(current) > 0 && claimed.Cmp(desired) >= 0 {
+		requests[api.ResourceStorage] = desired
+	}
+}
+
+func storageRequest(requests interface{}) (resource.Quantity, bool) {
+	m, ok := requests.(map[string]interface{})
+	if !ok {
+		return resource.Quantity{}, false
+	}
+	v, ok := m[string(api.ResourceStorage)].(string)
+	if !ok || v == "" {
+		return resource.Quantity{}, false
+	}
+	q, err := resource.ParseQuantity(v)
+	if err != nil {
+		return resource.Quantity{}, false
+	}
+	return q, true
+}
@@ -1,11 +1,14 @@
 package datavolume
 
 import (
+	"context"
 	"testing"
 
 	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/test_utils/expand_utils"
 	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/test_utils/flatten_utils"
 	"gotest.tools/assert"
+	api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
@@ -104,3 +107,149 @@ func nullifyUncomparableFields(output *[]interface{}) {
 
 	test_utils.NullifySchemaSetFunction(accessModes.(*schema.Set))
 }
+
+func TestStorageRequestDecreased(t *testing.T) {
+	cases := []struct {
+		name     string
+		old      interface{}
+		new      interface{}
+		expected bool
+	}{
+		{
+			name:     "expanded",
+			old:      map[string]interface{}{"storage": "10Gi"},
+			new:      map[string]interface{}{"storage": "20Gi"},
+			expected: false,
+		},
+		{
+			name:     "shrunk",
+			old:      map[string]interface{}{"storage": "10Gi"},
+			new:      map[string]interface{}{"storage": "5Gi"},
+			expected: true,
+		},
+		{
+			name:     "same size in other units",
+			old:      map[string]interface{}{"storage": "1Gi"},
+			new:      map[string]interface{}{"storage": "1024Mi"},
+			expected: false,
+		},
+		{
+			name:     "not previously set",
+			old:      map[string]interface{}{},
+			new:      map[string]interface{}{"storage": "5Gi"},
+			expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, StorageRequestDecreased(context.Background(), tc.old, tc.new, nil))
+		})
+	}
+}
+
+func TestSyncClaimStorageRequest(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, DataVolumeFields(), map[string]interface{}{
+		"metadata": []interface{}{
+			map[string]interface{}{
+				"name": "test-dv",
+			},
+		},
+		"spec": []interface{}{
+			map[string]interface{}{
+				"storage": []interface{}{
+					map[string]interface{}{
+						"resources": []interface{}{
+							map[string]interface{}{
+								"requests": map[string]interface{}{
+									"storage": "20Gi",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	cases := []struct {
+		name     string
+		claimed  string
+		expected string
+	}{
+		{
+			name:     "claim expanded",
+			claimed:  "20Gi",
+			expected: "20Gi",
+		},
+		{
+			name:     "claim expanded with filesystem overhead",
+			claimed:  "21Gi",
+			expected: "20Gi",
+		},
+		{
+			name:     "claim not expanded yet",
+			claimed:  "10Gi",
+			expected: "10Gi",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			dv := &cdiv1.DataVolume{
+				Spec: cdiv1.DataVolumeSpec{
+					Storage: &cdiv1.StorageSpec{
+						Resources: api.ResourceRequirements{
+							Requests: api.ResourceList{
+								api.ResourceStorage: resource.MustParse("10Gi"),
+							},
+						},
+					},
+				},
+			}
+			pvc := &api.PersistentVolumeClaim{
+				Spec: api.PersistentVolumeClaimSpec{
+					Resources: api.ResourceRequirements{
+						Requests: api.ResourceList{
+							api.ResourceStorage: resource.MustParse(tc.claimed),
+						},
+					},
+				},
+			}
+
+			SyncClaimStorageRequest(dv, pvc, resourceData)
+
+			size := dv.Spec.Storage.Resources.Requests[api.ResourceStorage]
+			assert.Equal(t, tc.expected, size.String())
+		})
+	}
+}
+
+func TestDataVolumeSpecForceNew(t *testing.T) {
+	standalone := DataVolumeFields()["spec"].Elem.(*schema.Resource).Schema
+	template := DataVolumeSpecSchema().Elem.(*schema.Resource).Schema
+
+	for _, key := range []string{"source", "source_ref", "content_type"} {
+		assert.Assert(t, standalone[key].ForceNew, key)
+		assert.Assert(t, !template[key].ForceNew, key)
+	}
+
+	standaloneHTTP := standalone["source"].Elem.(*schema.Resource).Schema["http"].Elem.(*schema.Resource).Schema
+	templateHTTP := template["source"].Elem.(*schema.Resource).Schema["http"].Elem.(*schema.Resource).Schema
+	assert.Assert(t, standaloneHTTP["url"].ForceNew)
+	assert.Assert(t, !templateHTTP["url"].ForceNew)
+
+	for _, claim := range []string{"pvc", "storage"} {
+		fields := standalone[claim].Elem.(*schema.Resource).Schema
+		assert.Assert(t, fields["access_modes"].ForceNew, claim)
+
+		requests := fields["resources"].Elem.(*schema.Resource).Schema["requests"]
+		assert.Assert(t, !requests.ForceNew, claim)
+	}
+	pvc := standalone["pvc"].Elem.(*schema.Resource).Schema
+	for _, key := range []string{"storage_class_name", "volume_name", "selector"} {
+		assert.Assert(t, pvc[key].ForceNew, key)
+	}
+	templatePVC := template["pvc"].Elem.(*schema.Resource).Schema
+	assert.Assert(t, !templatePVC["access_modes"].ForceNew)
+}
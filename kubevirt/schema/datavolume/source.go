@@ -2,20 +2,57 @@ package datavolume
 
 import (
 	"fmt"
+	"sort"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils"
 	api "k8s.io/api/core/v1"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 )
 
-func dataVolumeSourceFields() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
-		"http": dataVolumeSourceHTTPSchema(),
-		"pvc":  dataVolumeSourcePVCSchema(),
+// dataVolumeSourceFields returns the sources of a DataVolume. When the key of
+// the source block is known, the schema requires exactly one of them; inside
+// lists it can't be addressed, and CDI rejects the DataVolume instead.
+func dataVolumeSourceFields(key string) map[string]*schema.Schema {
+	fields := map[string]*schema.Schema{
+		"http":     dataVolumeSourceHTTPSchema(),
+		"pvc":      dataVolumeSourcePVCSchema(),
+		"registry": dataVolumeSourceRegistrySchema(),
+		"s3":       dataVolumeSourceS3Schema(),
+		"blank": {
+			Type:         schema.TypeBool,
+			Description:  "Create an empty DataVolume.",
+			Optional:     true,
+			ValidateFunc: utils.ValidateTrue,
+		},
+		"upload": {
+			Type:         schema.TypeBool,
+			Description:  "Create a DataVolume waiting for its content to be uploaded through the CDI upload proxy.",
+			Optional:     true,
+			ValidateFunc: utils.ValidateTrue,
+		},
+		"imageio":  dataVolumeSourceImageIOSchema(),
+		"vddk":     dataVolumeSourceVDDKSchema(),
+		"snapshot": dataVolumeSourceSnapshotSchema(),
+	}
+
+	if key != "" {
+		sources := make([]string, 0, len(fields))
+		for name := range fields {
+			sources = append(sources, key+name)
+		}
+		sort.Strings(sources)
+		for _, field := range fields {
+			field.ExactlyOneOf = sources
+		}
 	}
+
+	return fields
 }
 
-func dataVolumeSourceSchema() *schema.Schema {
-	fields := dataVolumeSourceFields()
+func dataVolumeSourceSchema(key string) *schema.Schema {
+	fields := dataVolumeSourceFields(key)
 
 	return &schema.Schema{
 		Type:        schema.TypeList,
@@ -46,6 +83,19 @@ func dataVolumeSourceHTTPFields() map[string]*schema.Schema {
 			Description: "Cert_config_map provides a reference to the Registry certs.",
 			Optional:    true,
 		},
+		"extra_headers": {
+			Type:        schema.TypeList,
+			Description: "Extra_headers is a list of \"Name: value\" headers sent with the HTTP requests.",
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"secret_extra_headers": {
+			Type:        schema.TypeList,
+			Description: "Secret_extra_headers is a list of secrets, each holding a header sent with the HTTP requests, such as a bearer token.",
+			Optional:    true,
+			Sensitive:   true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
 	}
 }
 
@@ -94,6 +144,210 @@ func dataVolumeSourcePVCSchema() *schema.Schema {
 
 }
 
+func dataVolumeSourceRegistryFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"url": {
+			Type:        schema.TypeString,
+			Description: "URL of the registry source, starting with docker:// or oci-archive://.",
+			Optional:    true,
+		},
+		"image_stream": {
+			Type:        schema.TypeString,
+			Description: "Name of the image stream for import, OpenShift only.",
+			Optional:    true,
+		},
+		"pull_method": {
+			Type:        schema.TypeString,
+			Description: "Method of pulling the image: \"pod\" (default) or \"node\", which uses the container runtime of the node.",
+			Optional:    true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(cdiv1.RegistryPullPod),
+				string(cdiv1.RegistryPullNode),
+			}, false),
+		},
+		"secret_ref": {
+			Type:        schema.TypeString,
+			Description: "Secret_ref provides the secret reference needed to access the registry source.",
+			Optional:    true,
+		},
+		"cert_config_map": {
+			Type:        schema.TypeString,
+			Description: "Cert_config_map provides a reference to the registry certs.",
+			Optional:    true,
+		},
+	}
+}
+
+func dataVolumeSourceRegistrySchema() *schema.Schema {
+	fields := dataVolumeSourceRegistryFields()
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "DataVolumeSourceRegistry provides the parameters to create a Data Volume from a container registry.",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+
+}
+
+func dataVolumeSourceS3Fields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"url": {
+			Type:        schema.TypeString,
+			Description: "url is the URL of the S3 object.",
+			Required:    true,
+		},
+		"secret_ref": {
+			Type:        schema.TypeString,
+			Description: "Secret_ref provides the secret holding the access key id and secret key of the S3 bucket.",
+			Optional:    true,
+		},
+		"cert_config_map": {
+			Type:        schema.TypeString,
+			Description: "Cert_config_map provides a reference to the certs of the S3 endpoint.",
+			Optional:    true,
+		},
+	}
+}
+
+func dataVolumeSourceS3Schema() *schema.Schema {
+	fields := dataVolumeSourceS3Fields()
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "DataVolumeSourceS3 provides the parameters to create a Data Volume from an S3 source.",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+
+}
+
+func dataVolumeSourceImageIOFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"url": {
+			Type:        schema.TypeString,
+			Description: "url is the URL of the ovirt-engine.",
+			Required:    true,
+		},
+		"disk_id": {
+			Type:        schema.TypeString,
+			Description: "Disk_id is the id of the disk to import.",
+			Required:    true,
+		},
+		"secret_ref": {
+			Type:        schema.TypeString,
+			Description: "Secret_ref provides the secret reference needed to access the ovirt-engine.",
+			Optional:    true,
+		},
+		"cert_config_map": {
+			Type:        schema.TypeString,
+			Description: "Cert_config_map provides a reference to the CA certs of the ovirt-engine.",
+			Optional:    true,
+		},
+	}
+}
+
+func dataVolumeSourceImageIOSchema() *schema.Schema {
+	fields := dataVolumeSourceImageIOFields()
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "DataVolumeSourceImageIO provides the parameters to create a Data Volume from an imageio source.",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+
+}
+
+func dataVolumeSourceVDDKFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"url": {
+			Type:        schema.TypeString,
+			Description: "url is the URL of the vCenter or ESXi host with the VM to migrate.",
+			Optional:    true,
+		},
+		"uuid": {
+			Type:        schema.TypeString,
+			Description: "UUID of the virtual machine that the backing file is attached to in vCenter/ESXi.",
+			Optional:    true,
+		},
+		"backing_file": {
+			Type:        schema.TypeString,
+			Description: "Backing_file is the path to the virtual hard disk to migrate from vCenter/ESXi.",
+			Optional:    true,
+		},
+		"thumbprint": {
+			Type:        schema.TypeString,
+			Description: "Thumbprint is the certificate thumbprint of the vCenter or ESXi host.",
+			Optional:    true,
+		},
+		"secret_ref": {
+			Type:        schema.TypeString,
+			Description: "Secret_ref provides the secret holding the credentials of the vCenter or ESXi host.",
+			Optional:    true,
+		},
+		"init_image_url": {
+			Type:        schema.TypeString,
+			Description: "Init_image_url is the URL of the image holding the VDDK library.",
+			Optional:    true,
+		},
+	}
+}
+
+func dataVolumeSourceVDDKSchema() *schema.Schema {
+	fields := dataVolumeSourceVDDKFields()
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "DataVolumeSourceVDDK provides the parameters to create a Data Volume from a Vmware source.",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+
+}
+
+func dataVolumeSourceSnapshotFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"namespace": {
+			Type:        schema.TypeString,
+			Description: "The namespace which the VolumeSnapshot located in.",
+			Optional:    true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "The name of the VolumeSnapshot.",
+			Required:    true,
+		},
+	}
+}
+
+func dataVolumeSourceSnapshotSchema() *schema.Schema {
+	fields := dataVolumeSourceSnapshotFields()
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "DataVolumeSourceSnapshot provides the parameters to create a Data Volume from an existing VolumeSnapshot.",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+
+}
+
 func dataVolumeSourceRefFields() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"kind": {
@@ -140,8 +394,33 @@ func expandDataVolumeSource(dataVolumeSource []interface{}) *cdiv1.DataVolumeSou
 
 	in := dataVolumeSource[0].(map[string]interface{})
 
-	result.HTTP = expandDataVolumeSourceHTTP(in["http"].([]interface{}))
-	result.PVC = expandDataVolumeSourcePVC(in["pvc"].([]interface{}))
+	if v, ok := in["http"].([]interface{}); ok {
+		result.HTTP = expandDataVolumeSourceHTTP(v)
+	}
+	if v, ok := in["pvc"].([]interface{}); ok {
+		result.PVC = expandDataVolumeSourcePVC(v)
+	}
+	if v, ok := in["registry"].([]interface{}); ok {
+		result.Registry = expandDataVolumeSourceRegistry(v)
+	}
+	if v, ok := in["s3"].([]interface{}); ok {
+		result.S3 = expandDataVolumeSourceS3(v)
+	}
+	if v, ok := in["blank"].(bool); ok && v {
+		result.Blank = &cdiv1.DataVolumeBlankImage{}
+	}
+	if v, ok := in["upload"].(bool); ok && v {
+		result.Upload = &cdiv1.DataVolumeSourceUpload{}
+	}
+	if v, ok := in["imageio"].([]interface{}); ok {
+		result.Imageio = expandDataVolumeSourceImageIO(v)
+	}
+	if v, ok := in["vddk"].([]interface{}); ok {
+		result.VDDK = expandDataVolumeSourceVDDK(v)
+	}
+	if v, ok := in["snapshot"].([]interface{}); ok {
+		result.Snapshot = expandDataVolumeSourceSnapshot(v)
+	}
 
 	return result
 }
@@ -164,6 +443,12 @@ func expandDataVolumeSourceHTTP(dataVolumeSourceHTTP []interface{}) *cdiv1.DataV
 	if v, ok := in["cert_config_map"].(string); ok {
 		result.CertConfigMap = v
 	}
+	if v, ok := in["extra_headers"].([]interface{}); ok && len(v) > 0 {
+		result.ExtraHeaders = utils.ExpandStringSlice(v)
+	}
+	if v, ok := in["secret_extra_headers"].([]interface{}); ok && len(v) > 0 {
+		result.SecretExtraHeaders = utils.ExpandStringSlice(v)
+	}
 
 	return result
 }
@@ -187,6 +472,132 @@ func expandDataVolumeSourcePVC(dataVolumeSourcePVC []interface{}) *cdiv1.DataVol
 	return result
 }
 
+func expandDataVolumeSourceRegistry(dataVolumeSourceRegistry []interface{}) *cdiv1.DataVolumeSourceRegistry {
+	if len(dataVolumeSourceRegistry) == 0 || dataVolumeSourceRegistry[0] == nil {
+		return nil
+	}
+
+	result := &cdiv1.DataVolumeSourceRegistry{}
+
+	in := dataVolumeSourceRegistry[0].(map[string]interface{})
+
+	if v, ok := in["url"].(string); ok && v != "" {
+		result.URL = &v
+	}
+	if v, ok := in["image_stream"].(string); ok && v != "" {
+		result.ImageStream = &v
+	}
+	if v, ok := in["pull_method"].(string); ok && v != "" {
+		pullMethod := cdiv1.RegistryPullMethod(v)
+		result.PullMethod = &pullMethod
+	}
+	if v, ok := in["secret_ref"].(string); ok && v != "" {
+		result.SecretRef = &v
+	}
+	if v, ok := in["cert_config_map"].(string); ok && v != "" {
+		result.CertConfigMap = &v
+	}
+
+	return result
+}
+
+func expandDataVolumeSourceS3(dataVolumeSourceS3 []interface{}) *cdiv1.DataVolumeSourceS3 {
+	if len(dataVolumeSourceS3) == 0 || dataVolumeSourceS3[0] == nil {
+		return nil
+	}
+
+	result := &cdiv1.DataVolumeSourceS3{}
+
+	in := dataVolumeSourceS3[0].(map[string]interface{})
+
+	if v, ok := in["url"].(string); ok {
+		result.URL = v
+	}
+	if v, ok := in["secret_ref"].(string); ok {
+		result.SecretRef = v
+	}
+	if v, ok := in["cert_config_map"].(string); ok {
+		result.CertConfigMap = v
+	}
+
+	return result
+}
+
+func expandDataVolumeSourceImageIO(dataVolumeSourceImageIO []interface{}) *cdiv1.DataVolumeSourceImageIO {
+	if len(dataVolumeSourceImageIO) == 0 || dataVolumeSourceImageIO[0] == nil {
+		return nil
+	}
+
+	result := &cdiv1.DataVolumeSourceImageIO{}
+
+	in := dataVolumeSourceImageIO[0].(map[string]interface{})
+
+	if v, ok := in["url"].(string); ok {
+		result.URL = v
+	}
+	if v, ok := in["disk_id"].(string); ok {
+		result.DiskID = v
+	}
+	if v, ok := in["secret_ref"].(string); ok {
+		result.SecretRef = v
+	}
+	if v, ok := in["cert_config_map"].(string); ok {
+		result.CertConfigMap = v
+	}
+
+	return result
+}
+
+func expandDataVolumeSourceVDDK(dataVolumeSourceVDDK []interface{}) *cdiv1.DataVolumeSourceVDDK {
+	if len(dataVolumeSourceVDDK) == 0 || dataVolumeSourceVDDK[0] == nil {
+		return nil
+	}
+
+	result := &cdiv1.DataVolumeSourceVDDK{}
+
+	in := dataVolumeSourceVDDK[0].(map[string]interface{})
+
+	if v, ok := in["url"].(string); ok {
+		result.URL = v
+	}
+	if v, ok := in["uuid"].(string); ok {
+		result.UUID = v
+	}
+	if v, ok := in["backing_file"].(string); ok {
+		result.BackingFile = v
+	}
+	if v, ok := in["thumbprint"].(string); ok {
+		result.Thumbprint = v
+	}
+	if v, ok := in["secret_ref"].(string); ok {
+		result.SecretRef = v
+	}
+	if v, ok := in["init_image_url"].(string); ok {
+		result.InitImageURL = v
+	}
+
+	return result
+}
+
+func expandDataVolumeSourceSnapshot(dataVolumeSourceSnapshot []interface{}) *cdiv1.DataVolumeSourceSnapshot {
+	if len(dataVolumeSourceSnapshot) == 0 || dataVolumeSourceSnapshot[0] == nil {
+		return nil
+	}
+
+	result := &cdiv1.DataVolumeSourceSnapshot{}
+
+	in := dataVolumeSourceSnapshot[0].(map[string]interface{})
+
+	if v, ok := in["namespace"].(string); ok {
+		result.Namespace = v
+	}
+	if v, ok := in["name"].(string); ok {
+		result.Name = v
+	}
+
+	return result
+}
+
 func expandDataVolumeSourceRef(dataVolumeSourceRef []interface{}) *cdiv1.DataVolumeSourceRef {
 	if len(dataVolumeSourceRef) == 0 || dataVolumeSourceRef[0] == nil {
 		return nil
@@ -228,6 +639,27 @@ func flattenDataVolumeSource(in *cdiv1.DataVolumeSource) []interface{} {
 	if in.PVC != nil {
 		att["pvc"] = flattenDataVolumeSourcePVC(*in.PVC)
 	}
+	if in.Registry != nil {
+		att["registry"] = flattenDataVolumeSourceRegistry(*in.Registry)
+	}
+	if in.S3 != nil {
+		att["s3"] = flattenDataVolumeSourceS3(*in.S3)
+	}
+	if in.Blank != nil {
+		att["blank"] = true
+	}
+	if in.Upload != nil {
+		att["upload"] = true
+	}
+	if in.Imageio != nil {
+		att["imageio"] = flattenDataVolumeSourceImageIO(*in.Imageio)
+	}
+	if in.VDDK != nil {
+		att["vddk"] = flattenDataVolumeSourceVDDK(*in.VDDK)
+	}
+	if in.Snapshot != nil {
+		att["snapshot"] = flattenDataVolumeSourceSnapshot(*in.Snapshot)
+	}
 
 	return []interface{}{att}
 }
@@ -238,6 +670,12 @@ func flattenDataVolumeSourceHTTP(in cdiv1.DataVolumeSourceHTTP) []interface{} {
 		"secret_ref":      in.SecretRef,
 		"cert_config_map": in.CertConfigMap,
 	}
+	if len(in.ExtraHeaders) > 0 {
+		att["extra_headers"] = utils.FlattenStringSlice(in.ExtraHeaders)
+	}
+	if len(in.SecretExtraHeaders) > 0 {
+		att["secret_extra_headers"] = utils.FlattenStringSlice(in.SecretExtraHeaders)
+	}
 	return []interface{}{att}
 }
 
@@ -249,11 +687,74 @@ func flattenDataVolumeSourcePVC(in cdiv1.DataVolumeSourcePVC) []interface{} {
 	return []interface{}{att}
 }
 
-func flattenDataVolumeSourceRef(in cdiv1.DataVolumeSourceRef) []interface{} {
+func flattenDataVolumeSourceRegistry(in cdiv1.DataVolumeSourceRegistry) []interface{} {
+	att := make(map[string]interface{})
+
+	if in.URL != nil {
+		att["url"] = *in.URL
+	}
+	if in.ImageStream != nil {
+		att["image_stream"] = *in.ImageStream
+	}
+	if in.PullMethod != nil {
+		att["pull_method"] = string(*in.PullMethod)
+	}
+	if in.SecretRef != nil {
+		att["secret_ref"] = *in.SecretRef
+	}
+	if in.CertConfigMap != nil {
+		att["cert_config_map"] = *in.CertConfigMap
+	}
+
+	return []interface{}{att}
+}
+
+func flattenDataVolumeSourceS3(in cdiv1.DataVolumeSourceS3) []interface{} {
+	att := map[string]interface{}{
+		"url":             in.URL,
+		"secret_ref":      in.SecretRef,
+		"cert_config_map": in.CertConfigMap,
+	}
+	return []interface{}{att}
+}
+
+func flattenDataVolumeSourceImageIO(in cdiv1.DataVolumeSourceImageIO) []interface{} {
+	att := map[string]interface{}{
+		"url":             in.URL,
+		"disk_id":         in.DiskID,
+		"secret_ref":      in.SecretRef,
+		"cert_config_map": in.CertConfigMap,
+	}
+	return []interface{}{att}
+}
+
+func flattenDataVolumeSourceVDDK(in cdiv1.DataVolumeSourceVDDK) []interface{} {
 	att := map[string]interface{}{
-		"namespace": *in.Namespace,
+		"url":            in.URL,
+		"uuid":           in.UUID,
+		"backing_file":   in.BackingFile,
+		"thumbprint":     in.Thumbprint,
+		"secret_ref":     in.SecretRef,
+		"init_image_url": in.InitImageURL,
+	}
+	return []interface{}{att}
+}
+
+func flattenDataVolumeSourceSnapshot(in cdiv1.DataVolumeSourceSnapshot) []interface{} {
+	att := map[string]interface{}{
+		"namespace": in.Namespace,
 		"name":      in.Name,
-		"kind":      in.Kind,
+	}
+	return []interface{}{att}
+}
+
+func flattenDataVolumeSourceRef(in cdiv1.DataVolumeSourceRef) []interface{} {
+	att := map[string]interface{}{
+		"name": in.Name,
+		"kind": in.Kind,
+	}
+	if in.Namespace != nil {
+		att["namespace"] = *in.Namespace
 	}
 	return []interface{}{att}
 }
@@ -0,0 +1,155 @@
+package datavolume
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/pointer"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+)
+
+func TestExpandFlattenDataVolumeSource(t *testing.T) {
+	pullNode := cdiv1.RegistryPullNode
+
+	tests := []struct {
+		name   string
+		source cdiv1.DataVolumeSource
+	}{
+		{
+			name: "http with headers",
+			source: cdiv1.DataVolumeSource{
+				HTTP: &cdiv1.DataVolumeSourceHTTP{
+					URL:                "https://artifacts.example.com/images/fedora-38.qcow2",
+					ExtraHeaders:       []string{"X-Build-Id: 1234"},
+					SecretExtraHeaders: []string{"artifact-store-token"},
+				},
+			},
+		},
+		{
+			name: "registry",
+			source: cdiv1.DataVolumeSource{
+				Registry: &cdiv1.DataVolumeSourceRegistry{
+					URL:           pointer.String("docker://quay.io/containerdisks/fedora:38"),
+					PullMethod:    &pullNode,
+					SecretRef:     pointer.String("quay-credentials"),
+					CertConfigMap: pointer.String("quay-certs"),
+				},
+			},
+		},
+		{
+			name: "registry image stream",
+			source: cdiv1.DataVolumeSource{
+				Registry: &cdiv1.DataVolumeSourceRegistry{
+					ImageStream: pointer.String("fedora"),
+				},
+			},
+		},
+		{
+			name: "s3",
+			source: cdiv1.DataVolumeSource{
+				S3: &cdiv1.DataVolumeSourceS3{
+					URL:       "https://s3.example.com/images/fedora-38.qcow2",
+					SecretRef: "s3-credentials",
+				},
+			},
+		},
+		{
+			name:   "blank",
+			source: cdiv1.DataVolumeSource{Blank: &cdiv1.DataVolumeBlankImage{}},
+		},
+		{
+			name:   "upload",
+			source: cdiv1.DataVolumeSource{Upload: &cdiv1.DataVolumeSourceUpload{}},
+		},
+		{
+			name: "imageio",
+			source: cdiv1.DataVolumeSource{
+				Imageio: &cdiv1.DataVolumeSourceImageIO{
+					URL:           "https://engine.example.com/ovirt-engine/api",
+					DiskID:        "9a5d3c7e-2f4b-4b8e-9d6a-1c2b3d4e5f60",
+					SecretRef:     "engine-credentials",
+					CertConfigMap: "engine-ca",
+				},
+			},
+		},
+		{
+			name: "vddk",
+			source: cdiv1.DataVolumeSource{
+				VDDK: &cdiv1.DataVolumeSourceVDDK{
+					URL:          "https://vcenter.example.com",
+					UUID:         "52260566-b032-36cb-55b1-79bf29e30490",
+					BackingFile:  "[datastore1] vm/vm.vmdk",
+					Thumbprint:   "20:6C:8A:5D:44:40:B3:79:4B:28:EA:76:13:60:90:6E:49:D9:D9:A3",
+					SecretRef:    "vcenter-credentials",
+					InitImageURL: "quay.io/example/vddk:8",
+				},
+			},
+		},
+		{
+			name: "snapshot",
+			source: cdiv1.DataVolumeSource{
+				Snapshot: &cdiv1.DataVolumeSourceSnapshot{
+					Namespace: "golden-images",
+					Name:      "fedora-38-snapshot",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, &tt.source, expandDataVolumeSource(flattenDataVolumeSource(&tt.source)))
+		})
+	}
+}
+
+func TestDataVolumeSourceExactlyOne(t *testing.T) {
+	tests := []struct {
+		name        string
+		source      map[string]interface{}
+		expectError bool
+	}{
+		{
+			name:   "one source",
+			source: map[string]interface{}{"blank": true},
+		},
+		{
+			name: "two sources",
+			source: map[string]interface{}{
+				"blank":    true,
+				"registry": []interface{}{map[string]interface{}{"url": "docker://quay.io/containerdisks/fedora:38"}},
+			},
+			expectError: true,
+		},
+		{
+			name:        "no source",
+			source:      map[string]interface{}{},
+			expectError: true,
+		},
+		{
+			name:        "blank set to false",
+			source:      map[string]interface{}{"blank": false},
+			expectError: true,
+		},
+		{
+			name:        "upload set to false",
+			source:      map[string]interface{}{"upload": false},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"metadata": []interface{}{map[string]interface{}{"name": "test-dv"}},
+				"spec": []interface{}{map[string]interface{}{
+					"source": []interface{}{tt.source},
+				}},
+			})
+			diags := (&schema.Resource{Schema: DataVolumeFields()}).Validate(config)
+			assert.Equal(t, tt.expectError, diags.HasError(), "%v", diags)
+		})
+	}
+}
@@ -10,9 +10,14 @@ import (
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 )
 
-func dataVolumeSpecFields() map[string]*schema.Schema {
+func dataVolumeSpecFields(key string) map[string]*schema.Schema {
+	sourceKey := ""
+	if key != "" {
+		sourceKey = key + ".0.source.0."
+	}
+
 	return map[string]*schema.Schema{
-		"source": dataVolumeSourceSchema(),
+		"source": dataVolumeSourceSchema(sourceKey),
 		"pvc":    k8s.PersistentVolumeClaimSpecSchema(),
 		"content_type": {
 			Type:        schema.TypeString,
@@ -28,8 +33,19 @@ func dataVolumeSpecFields() map[string]*schema.Schema {
 	}
 }
 
+// DataVolumeSpecSchema is the spec of a DataVolume nested in a list, such as a DataVolume template.
 func DataVolumeSpecSchema() *schema.Schema {
-	fields := dataVolumeSpecFields()
+	return dataVolumeSpecSchema("")
+}
+
+// DataVolumeSpecSchemaAt is the spec of a DataVolume found at key, e.g. "spec"; knowing where the
+// spec lives lets the schema require exactly one source.
+func DataVolumeSpecSchemaAt(key string) *schema.Schema {
+	return dataVolumeSpecSchema(key)
+}
+
+func dataVolumeSpecSchema(key string) *schema.Schema {
+	fields := dataVolumeSpecFields(key)
 
 	return &schema.Schema{
 		Type:        schema.TypeList,
@@ -52,14 +68,10 @@ func ExpandDataVolumeSpec(dataVolumeSpec []interface{}) (cdiv1.DataVolumeSpec, e
 
 	in := dataVolumeSpec[0].(map[string]interface{})
 
-	if in["source_ref"] == nil {
-		if source, ok := in["source"].([]interface{}); ok && len(source) > 0 {
-			result.Source = expandDataVolumeSource(source)
-		}
-	} else {
-		if sourceRef, ok := in["source_ref"].([]interface{}); ok && len(sourceRef) > 0 {
-			result.SourceRef = expandDataVolumeSourceRef(sourceRef)
-		}
+	if sourceRef, ok := in["source_ref"].([]interface{}); ok && len(sourceRef) > 0 {
+		result.SourceRef = expandDataVolumeSourceRef(sourceRef)
+	} else if source, ok := in["source"].([]interface{}); ok && len(source) > 0 {
+		result.Source = expandDataVolumeSource(source)
 	}
 
 	if pvcList, ok := in["pvc"].([]interface{}); ok && len(pvcList) > 0 {
@@ -0,0 +1,70 @@
+package datavolume
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Upload describes the local disk image streamed to a DataVolume with an upload source.
+type Upload struct {
+	Path     string
+	ProxyURL string
+	Insecure bool
+}
+
+func dataVolumeUploadSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Local disk image uploaded to the DataVolume through the CDI upload proxy, the source of the DataVolume has to be upload. The image is uploaded again, recreating the DataVolume, only when its SHA256 checksum changes; an image uploaded by other means is kept, as is the uploaded one when planning where the image doesn't exist.",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"path": {
+					Type:        schema.TypeString,
+					Description: "Path of the local disk image.",
+					Required:    true,
+				},
+				"proxy_url": {
+					Type:        schema.TypeString,
+					Description: "URL of the CDI upload proxy, defaults to the one reported by the CDIConfig of the cluster.",
+					Optional:    true,
+				},
+				"insecure": {
+					Type:        schema.TypeBool,
+					Description: "Whether the certificate of the upload proxy is trusted without being verified.",
+					Optional:    true,
+					Default:     false,
+				},
+			},
+		},
+	}
+}
+
+func dataVolumeUploadSHA256Schema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "SHA256 checksum of the disk image uploaded to the DataVolume.",
+		Computed:    true,
+	}
+}
+
+func ExpandUpload(upload []interface{}) *Upload {
+	if len(upload) == 0 || upload[0] == nil {
+		return nil
+	}
+
+	in := upload[0].(map[string]interface{})
+
+	result := &Upload{}
+	if v, ok := in["path"].(string); ok {
+		result.Path = v
+	}
+	if v, ok := in["proxy_url"].(string); ok {
+		result.ProxyURL = v
+	}
+	if v, ok := in["insecure"].(bool); ok {
+		result.Insecure = v
+	}
+
+	return result
+}
@@ -0,0 +1,238 @@
+package instancetype
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/k8s"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils/patch"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubevirtapiv1 "kubevirt.io/api/core/v1"
+	instancetypev1alpha2 "kubevirt.io/api/instancetype/v1alpha2"
+)
+
+// InstancetypeFields are the fields of a VirtualMachineInstancetype, or of a
+// VirtualMachineClusterInstancetype when it isn't namespaced.
+func InstancetypeFields(objectName string, namespaced bool) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"metadata": metadataSchema(objectName, namespaced),
+		"spec":     instancetypeSpecSchema(),
+	}
+}
+
+func metadataSchema(objectName string, namespaced bool) *schema.Schema {
+	if namespaced {
+		return k8s.NamespacedMetadataSchema(objectName, false)
+	}
+	return k8s.MetadataSchema(objectName, false)
+}
+
+func instancetypeSpecFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"cpu": {
+			Type:        schema.TypeList,
+			Description: "CPU of the virtual machines using the instancetype.",
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"guest": {
+						Type:         schema.TypeInt,
+						Description:  "Number of vCPUs exposed to the guest.",
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+					"model": {
+						Type:        schema.TypeString,
+						Description: "CPU model, such as \"host-passthrough\".",
+						Optional:    true,
+					},
+					"dedicated_cpu_placement": {
+						Type:        schema.TypeBool,
+						Description: "Pin each vCPU to a dedicated physical CPU of the node.",
+						Optional:    true,
+					},
+					"isolate_emulator_thread": {
+						Type:        schema.TypeBool,
+						Description: "Pin the QEMU emulator thread to an extra dedicated physical CPU, requires dedicated_cpu_placement.",
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"memory": {
+			Type:        schema.TypeList,
+			Description: "Memory of the virtual machines using the instancetype.",
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"guest": {
+						Type:         schema.TypeString,
+						Description:  "Amount of memory exposed to the guest, such as \"4Gi\".",
+						Required:     true,
+						ValidateFunc: utils.ValidateResourceQuantity,
+					},
+					"hugepages_page_size": {
+						Type:        schema.TypeString,
+						Description: "Back the memory of the guest with hugepages of this size, such as \"2Mi\" or \"1Gi\".",
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"gpus": {
+			Type:        schema.TypeList,
+			Description: "GPUs passed through to the virtual machines using the instancetype.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Description: "Name of the GPU in the virtual machine.",
+						Required:    true,
+					},
+					"device_name": {
+						Type:        schema.TypeString,
+						Description: "Name of the GPU resource, as exposed by a device plugin.",
+						Required:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func instancetypeSpecSchema() *schema.Schema {
+	fields := instancetypeSpecFields()
+
+	return &schema.Schema{
+		Type: schema.TypeList,
+
+		Description: fmt.Sprintf("VirtualMachineInstancetypeSpec is the resources and devices of the virtual machines using the instancetype."),
+		Required:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+func expandInstancetypeSpec(instancetypeSpec []interface{}) (instancetypev1alpha2.VirtualMachineInstancetypeSpec, error) {
+	result := instancetypev1alpha2.VirtualMachineInstancetypeSpec{}
+
+	if len(instancetypeSpec) == 0 || instancetypeSpec[0] == nil {
+		return result, nil
+	}
+
+	in := instancetypeSpec[0].(map[string]interface{})
+
+	if v, ok := in["cpu"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		cpu := v[0].(map[string]interface{})
+		if v, ok := cpu["guest"].(int); ok {
+			result.CPU.Guest = uint32(v)
+		}
+		if v, ok := cpu["model"].(string); ok {
+			result.CPU.Model = v
+		}
+		if v, ok := cpu["dedicated_cpu_placement"].(bool); ok {
+			result.CPU.DedicatedCPUPlacement = v
+		}
+		if v, ok := cpu["isolate_emulator_thread"].(bool); ok {
+			result.CPU.IsolateEmulatorThread = v
+		}
+	}
+	if v, ok := in["memory"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		memory := v[0].(map[string]interface{})
+		if v, ok := memory["guest"].(string); ok && v != "" {
+			guest, err := resource.ParseQuantity(v)
+			if err != nil {
+				return result, err
+			}
+			result.Memory.Guest = guest
+		}
+		if v, ok := memory["hugepages_page_size"].(string); ok && v != "" {
+			result.Memory.Hugepages = &kubevirtapiv1.Hugepages{PageSize: v}
+		}
+	}
+	if v, ok := in["gpus"].([]interface{}); ok && len(v) > 0 {
+		result.GPUs = make([]kubevirtapiv1.GPU, len(v))
+		for i, gpu := range v {
+			gpu := gpu.(map[string]interface{})
+			result.GPUs[i] = kubevirtapiv1.GPU{
+				Name:       gpu["name"].(string),
+				DeviceName: gpu["device_name"].(string),
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func flattenInstancetypeSpec(in instancetypev1alpha2.VirtualMachineInstancetypeSpec) []interface{} {
+	att := make(map[string]interface{})
+
+	att["cpu"] = []interface{}{map[string]interface{}{
+		"guest":                   int(in.CPU.Guest),
+		"model":                   in.CPU.Model,
+		"dedicated_cpu_placement": in.CPU.DedicatedCPUPlacement,
+		"isolate_emulator_thread": in.CPU.IsolateEmulatorThread,
+	}}
+
+	memory := map[string]interface{}{
+		"guest": in.Memory.Guest.String(),
+	}
+	if in.Memory.Hugepages != nil {
+		memory["hugepages_page_size"] = in.Memory.Hugepages.PageSize
+	}
+	att["memory"] = []interface{}{memory}
+
+	gpus := make([]interface{}, len(in.GPUs))
+	for i, gpu := range in.GPUs {
+		gpus[i] = map[string]interface{}{
+			"name":        gpu.Name,
+			"device_name": gpu.DeviceName,
+		}
+	}
+	att["gpus"] = gpus
+
+	return []interface{}{att}
+}
+
+func InstancetypeFromResourceData(resourceData *schema.ResourceData) (metav1.ObjectMeta, instancetypev1alpha2.VirtualMachineInstancetypeSpec, error) {
+	meta := k8s.ExpandMetadata(resourceData.Get("metadata").([]interface{}))
+	spec, err := expandInstancetypeSpec(resourceData.Get("spec").([]interface{}))
+
+	return meta, spec, err
+}
+
+func InstancetypeToResourceData(meta metav1.ObjectMeta, spec instancetypev1alpha2.VirtualMachineInstancetypeSpec, resourceData *schema.ResourceData) error {
+	if err := resourceData.Set("metadata", k8s.FlattenMetadata(meta)); err != nil {
+		return err
+	}
+	if err := resourceData.Set("spec", flattenInstancetypeSpec(spec)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func InstancetypeAppendPatchOps(keyPrefix, pathPrefix string, resourceData *schema.ResourceData, ops []patch.PatchOperation) (patch.PatchOperations, error) {
+	ops = k8s.AppendPatchOps(keyPrefix+"metadata.0.", pathPrefix+"/metadata/", resourceData, ops)
+
+	if resourceData.HasChange(keyPrefix + "spec") {
+		spec, err := expandInstancetypeSpec(resourceData.Get(keyPrefix + "spec").([]interface{}))
+		if err != nil {
+			return ops, err
+		}
+		ops = append(ops, &patch.ReplaceOperation{
+			Path:  pathPrefix + "/spec",
+			Value: spec,
+		})
+	}
+
+	return ops, nil
+}
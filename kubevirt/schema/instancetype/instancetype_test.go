@@ -0,0 +1,103 @@
+package instancetype
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	kubevirtapiv1 "kubevirt.io/api/core/v1"
+	instancetypev1alpha2 "kubevirt.io/api/instancetype/v1alpha2"
+
+	"gotest.tools/assert"
+)
+
+func TestExpandFlattenInstancetypeSpec(t *testing.T) {
+	spec := instancetypev1alpha2.VirtualMachineInstancetypeSpec{
+		CPU: instancetypev1alpha2.CPUInstancetype{
+			Guest:                 4,
+			Model:                 "host-passthrough",
+			DedicatedCPUPlacement: true,
+		},
+		Memory: instancetypev1alpha2.MemoryInstancetype{
+			Guest:     resource.MustParse("8Gi"),
+			Hugepages: &kubevirtapiv1.Hugepages{PageSize: "2Mi"},
+		},
+		GPUs: []kubevirtapiv1.GPU{
+			{Name: "gpu1", DeviceName: "nvidia.com/TU104GL_Tesla_T4"},
+		},
+	}
+
+	flattened := flattenInstancetypeSpec(spec)
+	assert.DeepEqual(t, flattened, []interface{}{
+		map[string]interface{}{
+			"cpu": []interface{}{
+				map[string]interface{}{
+					"guest":                   4,
+					"model":                   "host-passthrough",
+					"dedicated_cpu_placement": true,
+					"isolate_emulator_thread": false,
+				},
+			},
+			"memory": []interface{}{
+				map[string]interface{}{
+					"guest":               "8Gi",
+					"hugepages_page_size": "2Mi",
+				},
+			},
+			"gpus": []interface{}{
+				map[string]interface{}{
+					"name":        "gpu1",
+					"device_name": "nvidia.com/TU104GL_Tesla_T4",
+				},
+			},
+		},
+	})
+
+	expanded, err := expandInstancetypeSpec(flattened)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, expanded, spec)
+}
+
+func TestExpandFlattenPreferenceSpec(t *testing.T) {
+	efi := true
+	spec := instancetypev1alpha2.VirtualMachinePreferenceSpec{
+		CPU: &instancetypev1alpha2.CPUPreferences{
+			PreferredCPUTopology: instancetypev1alpha2.PreferSockets,
+		},
+		Devices: &instancetypev1alpha2.DevicePreferences{
+			PreferredDiskBus:        kubevirtapiv1.DiskBusVirtio,
+			PreferredInterfaceModel: "virtio",
+		},
+		Firmware: &instancetypev1alpha2.FirmwarePreferences{
+			PreferredUseEfi: &efi,
+		},
+		Machine: &instancetypev1alpha2.MachinePreferences{
+			PreferredMachineType: "q35",
+		},
+	}
+
+	flattened := flattenPreferenceSpec(spec)
+	assert.DeepEqual(t, flattened, []interface{}{
+		map[string]interface{}{
+			"cpu": []interface{}{
+				map[string]interface{}{"preferred_cpu_topology": "preferSockets"},
+			},
+			"devices": []interface{}{
+				map[string]interface{}{
+					"preferred_disk_bus":        "virtio",
+					"preferred_cdrom_bus":       "",
+					"preferred_lun_bus":         "",
+					"preferred_interface_model": "virtio",
+				},
+			},
+			"firmware": []interface{}{
+				map[string]interface{}{"preferred_use_efi": true},
+			},
+			"machine": []interface{}{
+				map[string]interface{}{"preferred_machine_type": "q35"},
+			},
+		},
+	})
+
+	expanded := expandPreferenceSpec(flattened)
+	assert.DeepEqual(t, expanded, spec)
+}
@@ -0,0 +1,278 @@
+package instancetype
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/k8s"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils/patch"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubevirtapiv1 "kubevirt.io/api/core/v1"
+	instancetypev1alpha2 "kubevirt.io/api/instancetype/v1alpha2"
+)
+
+// PreferenceFields are the fields of a VirtualMachinePreference, or of a
+// VirtualMachineClusterPreference when it isn't namespaced.
+func PreferenceFields(objectName string, namespaced bool) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"metadata": metadataSchema(objectName, namespaced),
+		"spec":     preferenceSpecSchema(),
+	}
+}
+
+func diskBusSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: description,
+		Optional:    true,
+		ValidateFunc: validation.StringInSlice([]string{
+			string(kubevirtapiv1.DiskBusVirtio),
+			string(kubevirtapiv1.DiskBusSATA),
+			string(kubevirtapiv1.DiskBusSCSI),
+			string(kubevirtapiv1.DiskBusUSB),
+		}, false),
+	}
+}
+
+func preferenceSpecFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"cpu": {
+			Type:        schema.TypeList,
+			Description: "CPU preferences of the virtual machines.",
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"preferred_cpu_topology": {
+						Type:        schema.TypeString,
+						Description: "How the vCPUs of the instancetype are exposed to the guest: \"preferSockets\", \"preferCores\" or \"preferThreads\".",
+						Optional:    true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(instancetypev1alpha2.PreferSockets),
+							string(instancetypev1alpha2.PreferCores),
+							string(instancetypev1alpha2.PreferThreads),
+						}, false),
+					},
+				},
+			},
+		},
+		"devices": {
+			Type:        schema.TypeList,
+			Description: "Device preferences of the virtual machines.",
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"preferred_disk_bus":  diskBusSchema("Bus of the disks not setting one."),
+					"preferred_cdrom_bus": diskBusSchema("Bus of the CD-ROMs not setting one."),
+					"preferred_lun_bus":   diskBusSchema("Bus of the LUNs not setting one."),
+					"preferred_interface_model": {
+						Type:        schema.TypeString,
+						Description: "Model of the network interfaces not setting one, such as \"virtio\" or \"e1000e\".",
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"firmware": {
+			Type:        schema.TypeList,
+			Description: "Firmware preferences of the virtual machines.",
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"preferred_use_bios": {
+						Type:        schema.TypeBool,
+						Description: "Boot the guest with a BIOS.",
+						Optional:    true,
+					},
+					"preferred_use_efi": {
+						Type:        schema.TypeBool,
+						Description: "Boot the guest with EFI.",
+						Optional:    true,
+					},
+					"preferred_use_secure_boot": {
+						Type:        schema.TypeBool,
+						Description: "Enable secure boot when booting with EFI.",
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"machine": {
+			Type:        schema.TypeList,
+			Description: "Machine preferences of the virtual machines.",
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"preferred_machine_type": {
+						Type:        schema.TypeString,
+						Description: "Machine type of the guest, such as \"q35\".",
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"volumes": {
+			Type:        schema.TypeList,
+			Description: "Volume preferences of the virtual machines.",
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"preferred_storage_class_name": {
+						Type:        schema.TypeString,
+						Description: "StorageClass of the data volume templates not setting one.",
+						Optional:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func preferenceSpecSchema() *schema.Schema {
+	fields := preferenceSpecFields()
+
+	return &schema.Schema{
+		Type: schema.TypeList,
+
+		Description: fmt.Sprintf("VirtualMachinePreferenceSpec is the preferred settings of the virtual machines using the preference."),
+		Required:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+func expandBlock(in map[string]interface{}, key string) map[string]interface{} {
+	if v, ok := in[key].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		return v[0].(map[string]interface{})
+	}
+	return nil
+}
+
+func expandPreferenceSpec(preferenceSpec []interface{}) instancetypev1alpha2.VirtualMachinePreferenceSpec {
+	result := instancetypev1alpha2.VirtualMachinePreferenceSpec{}
+
+	if len(preferenceSpec) == 0 || preferenceSpec[0] == nil {
+		return result
+	}
+
+	in := preferenceSpec[0].(map[string]interface{})
+
+	if cpu := expandBlock(in, "cpu"); cpu != nil {
+		result.CPU = &instancetypev1alpha2.CPUPreferences{
+			PreferredCPUTopology: instancetypev1alpha2.PreferredCPUTopology(cpu["preferred_cpu_topology"].(string)),
+		}
+	}
+	if devices := expandBlock(in, "devices"); devices != nil {
+		result.Devices = &instancetypev1alpha2.DevicePreferences{
+			PreferredDiskBus:        kubevirtapiv1.DiskBus(devices["preferred_disk_bus"].(string)),
+			PreferredCdromBus:       kubevirtapiv1.DiskBus(devices["preferred_cdrom_bus"].(string)),
+			PreferredLunBus:         kubevirtapiv1.DiskBus(devices["preferred_lun_bus"].(string)),
+			PreferredInterfaceModel: devices["preferred_interface_model"].(string),
+		}
+	}
+	if firmware := expandBlock(in, "firmware"); firmware != nil {
+		result.Firmware = &instancetypev1alpha2.FirmwarePreferences{}
+		if v, ok := firmware["preferred_use_bios"].(bool); ok && v {
+			result.Firmware.PreferredUseBios = &v
+		}
+		if v, ok := firmware["preferred_use_efi"].(bool); ok && v {
+			result.Firmware.PreferredUseEfi = &v
+		}
+		if v, ok := firmware["preferred_use_secure_boot"].(bool); ok && v {
+			result.Firmware.PreferredUseSecureBoot = &v
+		}
+	}
+	if machine := expandBlock(in, "machine"); machine != nil {
+		result.Machine = &instancetypev1alpha2.MachinePreferences{
+			PreferredMachineType: machine["preferred_machine_type"].(string),
+		}
+	}
+	if volumes := expandBlock(in, "volumes"); volumes != nil {
+		result.Volumes = &instancetypev1alpha2.VolumePreferences{
+			PreferredStorageClassName: volumes["preferred_storage_class_name"].(string),
+		}
+	}
+
+	return result
+}
+
+func flattenPreferenceSpec(in instancetypev1alpha2.VirtualMachinePreferenceSpec) []interface{} {
+	att := make(map[string]interface{})
+
+	if in.CPU != nil {
+		att["cpu"] = []interface{}{map[string]interface{}{
+			"preferred_cpu_topology": string(in.CPU.PreferredCPUTopology),
+		}}
+	}
+	if in.Devices != nil {
+		att["devices"] = []interface{}{map[string]interface{}{
+			"preferred_disk_bus":        string(in.Devices.PreferredDiskBus),
+			"preferred_cdrom_bus":       string(in.Devices.PreferredCdromBus),
+			"preferred_lun_bus":         string(in.Devices.PreferredLunBus),
+			"preferred_interface_model": in.Devices.PreferredInterfaceModel,
+		}}
+	}
+	if in.Firmware != nil {
+		firmware := make(map[string]interface{})
+		if in.Firmware.PreferredUseBios != nil {
+			firmware["preferred_use_bios"] = *in.Firmware.PreferredUseBios
+		}
+		if in.Firmware.PreferredUseEfi != nil {
+			firmware["preferred_use_efi"] = *in.Firmware.PreferredUseEfi
+		}
+		if in.Firmware.PreferredUseSecureBoot != nil {
+			firmware["preferred_use_secure_boot"] = *in.Firmware.PreferredUseSecureBoot
+		}
+		att["firmware"] = []interface{}{firmware}
+	}
+	if in.Machine != nil {
+		att["machine"] = []interface{}{map[string]interface{}{
+			"preferred_machine_type": in.Machine.PreferredMachineType,
+		}}
+	}
+	if in.Volumes != nil {
+		att["volumes"] = []interface{}{map[string]interface{}{
+			"preferred_storage_class_name": in.Volumes.PreferredStorageClassName,
+		}}
+	}
+
+	return []interface{}{att}
+}
+
+func PreferenceFromResourceData(resourceData *schema.ResourceData) (metav1.ObjectMeta, instancetypev1alpha2.VirtualMachinePreferenceSpec) {
+	meta := k8s.ExpandMetadata(resourceData.Get("metadata").([]interface{}))
+	spec := expandPreferenceSpec(resourceData.Get("spec").([]interface{}))
+
+	return meta, spec
+}
+
+func PreferenceToResourceData(meta metav1.ObjectMeta, spec instancetypev1alpha2.VirtualMachinePreferenceSpec, resourceData *schema.ResourceData) error {
+	if err := resourceData.Set("metadata", k8s.FlattenMetadata(meta)); err != nil {
+		return err
+	}
+	if err := resourceData.Set("spec", flattenPreferenceSpec(spec)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func PreferenceAppendPatchOps(keyPrefix, pathPrefix string, resourceData *schema.ResourceData, ops []patch.PatchOperation) patch.PatchOperations {
+	ops = k8s.AppendPatchOps(keyPrefix+"metadata.0.", pathPrefix+"/metadata/", resourceData, ops)
+
+	if resourceData.HasChange(keyPrefix + "spec") {
+		ops = append(ops, &patch.ReplaceOperation{
+			Path:  pathPrefix + "/spec",
+			Value: expandPreferenceSpec(resourceData.Get(keyPrefix + "spec").([]interface{})),
+		})
+	}
+
+	return ops
+}
@@ -212,6 +212,9 @@ func weightedPodAffinityTermFields() map[string]*schema.Schema {
 // Flatteners
 
 func FlattenAffinity(in *v1.Affinity) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
 	att := make(map[string]interface{})
 	if in.NodeAffinity != nil {
 		att["node_affinity"] = flattenNodeAffinity(in.NodeAffinity)
@@ -299,7 +302,7 @@ func flattenPodAffinityTerms(in []v1.PodAffinityTerm) []interface{} {
 		m["namespaces"] = utils.NewStringSet(schema.HashString, n.Namespaces)
 		m["topology_key"] = n.TopologyKey
 		if n.LabelSelector != nil {
-			m["label_selector"] = flattenLabelSelector(n.LabelSelector)
+			m["label_selector"] = FlattenLabelSelector(n.LabelSelector)
 		}
 		att[i] = m
 	}
@@ -450,7 +453,7 @@ func expandPodAffinityTerms(t []interface{}) []v1.PodAffinityTerm {
 	for i, n := range t {
 		in := n.(map[string]interface{})
 		if v, ok := in["label_selector"].([]interface{}); ok && len(v) > 0 {
-			obj[i].LabelSelector = expandLabelSelector(v)
+			obj[i].LabelSelector = ExpandLabelSelector(v)
 		}
 		if v, ok := in["namespaces"].(*schema.Set); ok {
 			obj[i].Namespaces = utils.SliceOfString(v.List())
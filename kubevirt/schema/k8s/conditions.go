@@ -0,0 +1,38 @@
+package k8s
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ConditionsSchema is the computed list of conditions of an object, as reported by its controller.
+func ConditionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Conditions of the object.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:        schema.TypeString,
+					Description: "Type of the condition.",
+					Computed:    true,
+				},
+				"status": {
+					Type:        schema.TypeString,
+					Description: "Status of the condition: \"True\", \"False\" or \"Unknown\".",
+					Computed:    true,
+				},
+				"reason": {
+					Type:        schema.TypeString,
+					Description: "Machine readable reason of the last transition.",
+					Computed:    true,
+				},
+				"message": {
+					Type:        schema.TypeString,
+					Description: "Human readable message of the last transition.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
@@ -47,9 +47,44 @@ func labelSelectorFields(updatable bool) map[string]*schema.Schema {
 	}
 }
 
+// LabelSelectorSchema is a label selector made of match_labels and match_expressions.
+func LabelSelectorSchema(description string, updatable bool) *schema.Schema {
+	fields := labelSelectorFields(updatable)
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Optional:    true,
+		ForceNew:    !updatable,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+// MatchLabelsSelectorSchema is a label selector restricted to match_labels, for APIs
+// selecting objects by a plain map of labels.
+func MatchLabelsSelectorSchema(description string, updatable bool) *schema.Schema {
+	fields := labelSelectorFields(updatable)
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Optional:    true,
+		ForceNew:    !updatable,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"match_labels": fields["match_labels"],
+			},
+		},
+	}
+}
+
 // Flatteners
 
-func flattenLabelSelector(in *metav1.LabelSelector) []interface{} {
+func FlattenLabelSelector(in *metav1.LabelSelector) []interface{} {
 	att := make(map[string]interface{})
 	if len(in.MatchLabels) > 0 {
 		att["match_labels"] = utils.FlattenStringMap(in.MatchLabels)
@@ -72,9 +107,22 @@ func flattenLabelSelectorRequirement(in []metav1.LabelSelectorRequirement) []int
 	return att
 }
 
+// FlattenMatchLabelsSelector flattens a selector made of a plain map of labels.
+func FlattenMatchLabelsSelector(in map[string]string) []interface{} {
+	if len(in) == 0 {
+		return []interface{}{}
+	}
+	return FlattenLabelSelector(&metav1.LabelSelector{MatchLabels: in})
+}
+
 // Expanders
 
-func expandLabelSelector(l []interface{}) *metav1.LabelSelector {
+// ExpandMatchLabelsSelector expands a selector made of a plain map of labels.
+func ExpandMatchLabelsSelector(l []interface{}) map[string]string {
+	return ExpandLabelSelector(l).MatchLabels
+}
+
+func ExpandLabelSelector(l []interface{}) *metav1.LabelSelector {
 	if len(l) == 0 || l[0] == nil {
 		return &metav1.LabelSelector{}
 	}
@@ -86,6 +86,11 @@ func metadataSchema(objectName string, generatableName bool) *schema.Schema {
 
 }
 
+// MetadataSchema is the metadata of a cluster-scoped object.
+func MetadataSchema(objectName string, generatableName bool) *schema.Schema {
+	return metadataSchema(objectName, generatableName)
+}
+
 func NamespacedMetadataSchema(objectName string, generatableName bool) *schema.Schema {
 	return namespacedMetadataSchemaIsTemplate(objectName, generatableName, false)
 }
@@ -127,6 +132,34 @@ func namespacedMetadataSchemaIsTemplate(objectName string, generatableName, isTe
 	}
 }
 
+// DataSourceNamespacedMetadataSchema is the metadata of a namespaced object looked up by a data source,
+// only its name and namespace are configurable.
+func DataSourceNamespacedMetadataSchema(objectName string) *schema.Schema {
+	fields := utils.DataSourceSchemaFromResourceSchema(metadataFields(objectName))
+	fields["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  fmt.Sprintf("Name of the %s to look up.", objectName),
+		Required:     true,
+		ValidateFunc: utils.ValidateName,
+	}
+	fields["namespace"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: fmt.Sprintf("Namespace of the %s to look up.", objectName),
+		Optional:    true,
+		Default:     "default",
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: fmt.Sprintf("Standard %s's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata", objectName),
+		Required:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
 func BuildId(meta metav1.ObjectMeta) string {
 	return meta.Namespace + "/" + meta.Name
 }
@@ -105,7 +105,7 @@ func FlattenPersistentVolumeClaimSpec(in v1.PersistentVolumeClaimSpec) []interfa
 		isSet = true
 	}
 	if in.Selector != nil {
-		att["selector"] = flattenLabelSelector(in.Selector)
+		att["selector"] = FlattenLabelSelector(in.Selector)
 		isSet = true
 	}
 	if in.VolumeName != "" {
@@ -152,7 +152,7 @@ func ExpandPersistentVolumeClaimSpec(l []interface{}) (*v1.PersistentVolumeClaim
 	obj.AccessModes = expandPersistentVolumeAccessModes(in["access_modes"].(*schema.Set).List())
 	obj.Resources = *resourceRequirements
 	if v, ok := in["selector"].([]interface{}); ok && len(v) > 0 {
-		obj.Selector = expandLabelSelector(v)
+		obj.Selector = ExpandLabelSelector(v)
 	}
 	if v, ok := in["volume_name"].(string); ok {
 		obj.VolumeName = v
@@ -0,0 +1,93 @@
+package k8s
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils"
+	v1 "k8s.io/api/core/v1"
+	kubevirtapiv1 "kubevirt.io/api/core/v1"
+)
+
+func typedLocalObjectReferenceFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"api_group": {
+			Type:        schema.TypeString,
+			Description: "APIGroup is the group of the referent.",
+			Optional:    true,
+			ForceNew:    true,
+			Default:     kubevirtapiv1.SchemeGroupVersion.Group,
+		},
+		"kind": {
+			Type:        schema.TypeString,
+			Description: "Kind is the type of the referent.",
+			Optional:    true,
+			ForceNew:    true,
+			Default:     "VirtualMachine",
+		},
+		"name": {
+			Type:         schema.TypeString,
+			Description:  "Name is the name of the referent.",
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: utils.ValidateName,
+		},
+	}
+}
+
+// TypedLocalObjectReferenceSchema references an object in the same namespace, a virtual machine by default.
+func TypedLocalObjectReferenceSchema(description string, required bool) *schema.Schema {
+	fields := typedLocalObjectReferenceFields()
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Required:    required,
+		Optional:    !required,
+		ForceNew:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+func ExpandTypedLocalObjectReference(typedLocalObjectReference []interface{}) v1.TypedLocalObjectReference {
+	result := v1.TypedLocalObjectReference{}
+
+	if len(typedLocalObjectReference) == 0 || typedLocalObjectReference[0] == nil {
+		return result
+	}
+
+	in := typedLocalObjectReference[0].(map[string]interface{})
+
+	if v, ok := in["api_group"].(string); ok && v != "" {
+		result.APIGroup = &v
+	}
+	if v, ok := in["kind"].(string); ok {
+		result.Kind = v
+	}
+	if v, ok := in["name"].(string); ok {
+		result.Name = v
+	}
+
+	return result
+}
+
+func FlattenTypedLocalObjectReference(typedLocalObjectReference v1.TypedLocalObjectReference) []interface{} {
+	att := make(map[string]interface{})
+
+	if typedLocalObjectReference.APIGroup != nil {
+		att["api_group"] = *typedLocalObjectReference.APIGroup
+	}
+	att["kind"] = typedLocalObjectReference.Kind
+	att["name"] = typedLocalObjectReference.Name
+
+	return []interface{}{att}
+}
+
+func ExpandTypedLocalObjectReferencePtr(typedLocalObjectReference []interface{}) *v1.TypedLocalObjectReference {
+	if len(typedLocalObjectReference) == 0 || typedLocalObjectReference[0] == nil {
+		return nil
+	}
+	result := ExpandTypedLocalObjectReference(typedLocalObjectReference)
+	return &result
+}
@@ -0,0 +1,233 @@
+package migrationpolicy
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/k8s"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils/patch"
+	"k8s.io/apimachinery/pkg/api/resource"
+	migrationsv1alpha1 "kubevirt.io/api/migrations/v1alpha1"
+)
+
+func MigrationPolicyFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"metadata": k8s.MetadataSchema("MigrationPolicy", false),
+		"spec":     migrationPolicySpecSchema(),
+	}
+}
+
+func migrationPolicySpecFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"selectors": selectorsSchema(),
+		"allow_auto_converge": {
+			Type:        schema.TypeBool,
+			Description: "Allows the platform to throttle the VMI's CPU so that the migration converges.",
+			Optional:    true,
+		},
+		"bandwidth_per_migration": {
+			Type:         schema.TypeString,
+			Description:  "Bandwidth limit of each migration, in bytes per second, such as \"64Mi\".",
+			Optional:     true,
+			ValidateFunc: utils.ValidateResourceQuantity,
+		},
+		"completion_timeout_per_gib": {
+			Type:        schema.TypeInt,
+			Description: "Seconds a migration may take per GiB of memory before it is cancelled.",
+			Optional:    true,
+		},
+		"allow_post_copy": {
+			Type:        schema.TypeBool,
+			Description: "Allows the migration to switch to post-copy mode when it doesn't converge.",
+			Optional:    true,
+		},
+	}
+}
+
+func migrationPolicySpecSchema() *schema.Schema {
+	fields := migrationPolicySpecFields()
+
+	return &schema.Schema{
+		Type: schema.TypeList,
+
+		Description: fmt.Sprintf("MigrationPolicySpec sets the migration configuration of the virtual machine instances it selects."),
+		Required:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+func selectorsFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"namespace_selector":                k8s.MatchLabelsSelectorSchema("Selects the namespaces whose virtual machine instances the policy applies to, by their labels.", true),
+		"virtual_machine_instance_selector": k8s.MatchLabelsSelectorSchema("Selects the virtual machine instances the policy applies to, by their labels.", true),
+	}
+}
+
+func selectorsSchema() *schema.Schema {
+	fields := selectorsFields()
+
+	return &schema.Schema{
+		Type: schema.TypeList,
+
+		Description: fmt.Sprintf("Selectors of the virtual machine instances the policy applies to. The policy with the most matching labels wins."),
+		Required:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+// expandMigrationPolicySpec expands the spec, the booleans are sent when they are true or configured,
+// so that false overrides the cluster-wide migration configuration.
+func expandMigrationPolicySpec(migrationPolicySpec []interface{}, configured func(key string) bool) (migrationsv1alpha1.MigrationPolicySpec, error) {
+	result := migrationsv1alpha1.MigrationPolicySpec{}
+
+	if len(migrationPolicySpec) == 0 || migrationPolicySpec[0] == nil {
+		return result, nil
+	}
+
+	in := migrationPolicySpec[0].(map[string]interface{})
+
+	if v, ok := in["selectors"].([]interface{}); ok {
+		result.Selectors = expandSelectors(v)
+	}
+	if v, ok := in["allow_auto_converge"].(bool); ok && (v || configured("allow_auto_converge")) {
+		result.AllowAutoConverge = &v
+	}
+	if v, ok := in["bandwidth_per_migration"].(string); ok && v != "" {
+		quantity, err := resource.ParseQuantity(v)
+		if err != nil {
+			return result, err
+		}
+		result.BandwidthPerMigration = &quantity
+	}
+	if v, ok := in["completion_timeout_per_gib"].(int); ok && v > 0 {
+		seconds := int64(v)
+		result.CompletionTimeoutPerGiB = &seconds
+	}
+	if v, ok := in["allow_post_copy"].(bool); ok && (v || configured("allow_post_copy")) {
+		result.AllowPostCopy = &v
+	}
+
+	return result, nil
+}
+
+func expandSelectors(selectors []interface{}) *migrationsv1alpha1.Selectors {
+	result := &migrationsv1alpha1.Selectors{}
+
+	if len(selectors) == 0 || selectors[0] == nil {
+		return result
+	}
+
+	in := selectors[0].(map[string]interface{})
+
+	if v, ok := in["namespace_selector"].([]interface{}); ok {
+		result.NamespaceSelector = k8s.ExpandMatchLabelsSelector(v)
+	}
+	if v, ok := in["virtual_machine_instance_selector"].([]interface{}); ok {
+		result.VirtualMachineInstanceSelector = k8s.ExpandMatchLabelsSelector(v)
+	}
+
+	return result
+}
+
+func flattenMigrationPolicySpec(in migrationsv1alpha1.MigrationPolicySpec) []interface{} {
+	att := make(map[string]interface{})
+
+	if in.Selectors != nil {
+		att["selectors"] = flattenSelectors(*in.Selectors)
+	}
+	if in.AllowAutoConverge != nil {
+		att["allow_auto_converge"] = *in.AllowAutoConverge
+	}
+	if in.BandwidthPerMigration != nil {
+		att["bandwidth_per_migration"] = in.BandwidthPerMigration.String()
+	}
+	if in.CompletionTimeoutPerGiB != nil {
+		att["completion_timeout_per_gib"] = int(*in.CompletionTimeoutPerGiB)
+	}
+	if in.AllowPostCopy != nil {
+		att["allow_post_copy"] = *in.AllowPostCopy
+	}
+
+	return []interface{}{att}
+}
+
+func flattenSelectors(in migrationsv1alpha1.Selectors) []interface{} {
+	att := make(map[string]interface{})
+
+	att["namespace_selector"] = k8s.FlattenMatchLabelsSelector(in.NamespaceSelector)
+	att["virtual_machine_instance_selector"] = k8s.FlattenMatchLabelsSelector(in.VirtualMachineInstanceSelector)
+
+	return []interface{}{att}
+}
+
+// specConfigured tells whether an attribute of the spec is set in the configuration, which
+// unlike the state tells a boolean set to false from an unset one.
+func specConfigured(resourceData *schema.ResourceData, keyPrefix string) func(key string) bool {
+	return func(key string) bool {
+		config := resourceData.GetRawConfig()
+		for _, step := range strings.Split(keyPrefix+"spec.0."+key, ".") {
+			if config.IsNull() || !config.IsKnown() {
+				return false
+			}
+			if index, err := strconv.Atoi(step); err == nil {
+				if index >= config.LengthInt() {
+					return false
+				}
+				config = config.AsValueSlice()[index]
+			} else {
+				config = config.GetAttr(step)
+			}
+		}
+		return !config.IsNull()
+	}
+}
+
+func FromResourceData(resourceData *schema.ResourceData) (*migrationsv1alpha1.MigrationPolicy, error) {
+	result := &migrationsv1alpha1.MigrationPolicy{}
+
+	result.ObjectMeta = k8s.ExpandMetadata(resourceData.Get("metadata").([]interface{}))
+	spec, err := expandMigrationPolicySpec(resourceData.Get("spec").([]interface{}), specConfigured(resourceData, ""))
+	if err != nil {
+		return result, err
+	}
+	result.Spec = spec
+
+	return result, nil
+}
+
+func ToResourceData(policy migrationsv1alpha1.MigrationPolicy, resourceData *schema.ResourceData) error {
+	if err := resourceData.Set("metadata", k8s.FlattenMetadata(policy.ObjectMeta)); err != nil {
+		return err
+	}
+	if err := resourceData.Set("spec", flattenMigrationPolicySpec(policy.Spec)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func AppendPatchOps(keyPrefix, pathPrefix string, resourceData *schema.ResourceData, ops []patch.PatchOperation) (patch.PatchOperations, error) {
+	ops = k8s.AppendPatchOps(keyPrefix+"metadata.0.", pathPrefix+"/metadata/", resourceData, ops)
+
+	if resourceData.HasChange(keyPrefix + "spec") {
+		spec, err := expandMigrationPolicySpec(resourceData.Get(keyPrefix+"spec").([]interface{}), specConfigured(resourceData, keyPrefix))
+		if err != nil {
+			return ops, err
+		}
+		ops = append(ops, &patch.ReplaceOperation{
+			Path:  pathPrefix + "/spec",
+			Value: spec,
+		})
+	}
+
+	return ops, nil
+}
@@ -0,0 +1,86 @@
+package migrationpolicy
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	migrationsv1alpha1 "kubevirt.io/api/migrations/v1alpha1"
+
+	"gotest.tools/assert"
+)
+
+func TestExpandFlattenMigrationPolicySpec(t *testing.T) {
+	allow := true
+	timeout := int64(150)
+	bandwidth := resource.MustParse("64Mi")
+
+	spec := migrationsv1alpha1.MigrationPolicySpec{
+		Selectors: &migrationsv1alpha1.Selectors{
+			NamespaceSelector:              migrationsv1alpha1.LabelSelector{"tier": "prod"},
+			VirtualMachineInstanceSelector: migrationsv1alpha1.LabelSelector{"workload": "db"},
+		},
+		AllowAutoConverge:       &allow,
+		BandwidthPerMigration:   &bandwidth,
+		CompletionTimeoutPerGiB: &timeout,
+		AllowPostCopy:           &allow,
+	}
+
+	flattened := flattenMigrationPolicySpec(spec)
+	assert.DeepEqual(t, flattened, []interface{}{
+		map[string]interface{}{
+			"selectors": []interface{}{
+				map[string]interface{}{
+					"namespace_selector": []interface{}{
+						map[string]interface{}{"match_labels": map[string]interface{}{"tier": "prod"}},
+					},
+					"virtual_machine_instance_selector": []interface{}{
+						map[string]interface{}{"match_labels": map[string]interface{}{"workload": "db"}},
+					},
+				},
+			},
+			"allow_auto_converge":        true,
+			"bandwidth_per_migration":    "64Mi",
+			"completion_timeout_per_gib": 150,
+			"allow_post_copy":            true,
+		},
+	})
+
+	expanded, err := expandMigrationPolicySpec(flattened, func(string) bool { return true })
+	assert.NilError(t, err)
+	assert.DeepEqual(t, expanded, spec)
+}
+
+func TestExpandMigrationPolicySpecDisallowed(t *testing.T) {
+	disallow := false
+
+	cases := []struct {
+		name       string
+		configured bool
+		expected   *bool
+	}{
+		{
+			name:       "set to false",
+			configured: true,
+			expected:   &disallow,
+		},
+		{
+			name:       "not set",
+			configured: false,
+			expected:   nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			expanded, err := expandMigrationPolicySpec([]interface{}{
+				map[string]interface{}{
+					"allow_auto_converge": false,
+					"allow_post_copy":     false,
+				},
+			}, func(string) bool { return tc.configured })
+			assert.NilError(t, err)
+			assert.DeepEqual(t, expanded.AllowAutoConverge, tc.expected)
+			assert.DeepEqual(t, expanded.AllowPostCopy, tc.expected)
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package virtualmachine
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	kubevirtapiv1 "kubevirt.io/api/core/v1"
+)
+
+// matcherSchema describes a reference from a virtual machine to an instancetype or a preference.
+// KubeVirt defaults the kind and records the revision it captured when the virtual machine is
+// created, so both are computed, and DiffVirtualMachineSpec drops a revision left over from
+// another instancetype or preference; and once it inferred the name from a volume it clears
+// inferFromVolume, which is why changes of that field are ignored after creation.
+func matcherSchema(object string, kinds []string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: fmt.Sprintf("Matcher of the %s applied to the virtual machine.", object),
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Description: fmt.Sprintf("Name of the %s.", object),
+					Optional:    true,
+					Computed:    true,
+				},
+				"kind": {
+					Type:         schema.TypeString,
+					Description:  fmt.Sprintf("Kind of the %s, defaults to %s.", object, kinds[1]),
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(kinds, false),
+				},
+				"revision_name": {
+					Type:        schema.TypeString,
+					Description: fmt.Sprintf("Name of the ControllerRevision holding the copy of the %s the virtual machine uses.", object),
+					Optional:    true,
+					Computed:    true,
+				},
+				"infer_from_volume": {
+					Type:        schema.TypeString,
+					Description: fmt.Sprintf("Name of a volume whose annotations the name and kind of the %s are inferred from.", object),
+					Optional:    true,
+					DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+						return d.Id() != "" && old == ""
+					},
+				},
+			},
+		},
+	}
+}
+
+func instancetypeMatcherSchema() *schema.Schema {
+	return matcherSchema("instancetype", []string{"VirtualMachineInstancetype", "VirtualMachineClusterInstancetype"})
+}
+
+func preferenceMatcherSchema() *schema.Schema {
+	return matcherSchema("preference", []string{"VirtualMachinePreference", "VirtualMachineClusterPreference"})
+}
+
+func expandInstancetypeMatcher(matcher []interface{}) *kubevirtapiv1.InstancetypeMatcher {
+	if len(matcher) == 0 || matcher[0] == nil {
+		return nil
+	}
+
+	in := matcher[0].(map[string]interface{})
+
+	return &kubevirtapiv1.InstancetypeMatcher{
+		Name:            in["name"].(string),
+		Kind:            in["kind"].(string),
+		RevisionName:    in["revision_name"].(string),
+		InferFromVolume: in["infer_from_volume"].(string),
+	}
+}
+
+func expandPreferenceMatcher(matcher []interface{}) *kubevirtapiv1.PreferenceMatcher {
+	if len(matcher) == 0 || matcher[0] == nil {
+		return nil
+	}
+
+	in := matcher[0].(map[string]interface{})
+
+	return &kubevirtapiv1.PreferenceMatcher{
+		Name:            in["name"].(string),
+		Kind:            in["kind"].(string),
+		RevisionName:    in["revision_name"].(string),
+		InferFromVolume: in["infer_from_volume"].(string),
+	}
+}
+
+func flattenInstancetypeMatcher(in kubevirtapiv1.InstancetypeMatcher) []interface{} {
+	att := make(map[string]interface{})
+
+	att["name"] = in.Name
+	att["kind"] = in.Kind
+	att["revision_name"] = in.RevisionName
+	att["infer_from_volume"] = in.InferFromVolume
+
+	return []interface{}{att}
+}
+
+func flattenPreferenceMatcher(in kubevirtapiv1.PreferenceMatcher) []interface{} {
+	att := make(map[string]interface{})
+
+	att["name"] = in.Name
+	att["kind"] = in.Kind
+	att["revision_name"] = in.RevisionName
+	att["infer_from_volume"] = in.InferFromVolume
+
+	return []interface{}{att}
+}
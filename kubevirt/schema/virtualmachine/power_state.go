@@ -0,0 +1,44 @@
+package virtualmachine
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	kubevirtapiv1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	// PowerStateRunning is the power state of a started virtual machine.
+	PowerStateRunning = "Running"
+	// PowerStateStopped is the power state of a stopped virtual machine.
+	PowerStateStopped = "Stopped"
+	// PowerStatePaused is the power state of a started virtual machine whose instance is paused.
+	PowerStatePaused = "Paused"
+)
+
+func powerStateSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "PowerState is the desired power state of the virtual machine: \"Running\", \"Stopped\" or \"Paused\". It is driven through the start, stop, pause and unpause subresources and requires the \"Manual\" run strategy.",
+		Optional:    true,
+		Computed:    true,
+		ValidateFunc: validation.StringInSlice([]string{
+			PowerStateRunning,
+			PowerStateStopped,
+			PowerStatePaused,
+		}, false),
+	}
+}
+
+// FlattenPowerState returns the power state matching the printable status of the virtual machine,
+// or an empty string while the virtual machine is transitioning between power states.
+func FlattenPowerState(in kubevirtapiv1.VirtualMachinePrintableStatus) string {
+	switch in {
+	case kubevirtapiv1.VirtualMachineStatusRunning:
+		return PowerStateRunning
+	case kubevirtapiv1.VirtualMachineStatusStopped:
+		return PowerStateStopped
+	case kubevirtapiv1.VirtualMachineStatusPaused:
+		return PowerStatePaused
+	}
+	return ""
+}
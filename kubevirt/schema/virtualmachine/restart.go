@@ -0,0 +1,59 @@
+package virtualmachine
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils"
+	k8sv1 "k8s.io/api/core/v1"
+	kubevirtapiv1 "kubevirt.io/api/core/v1"
+)
+
+// VirtualMachineRestartRequired is the condition KubeVirt raises on a virtual machine when changes
+// of its template only take effect once its instance is recreated. The API version this provider
+// builds against predates it.
+const VirtualMachineRestartRequired kubevirtapiv1.VirtualMachineConditionType = "RestartRequired"
+
+func VirtualMachineRestartFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"namespace": {
+			Type:        schema.TypeString,
+			Description: "Namespace of the virtual machine to restart.",
+			Optional:    true,
+			ForceNew:    true,
+			Default:     "default",
+		},
+		"name": {
+			Type:         schema.TypeString,
+			Description:  "Name of the virtual machine to restart.",
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: utils.ValidateName,
+		},
+		"triggers": {
+			Type:        schema.TypeMap,
+			Description: "Arbitrary map of values that, when changed, restart the virtual machine.",
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"restart_when_required": {
+			Type:        schema.TypeBool,
+			Description: "Restart the virtual machine whenever it reports the RestartRequired condition.",
+			Optional:    true,
+			Default:     false,
+		},
+		"restart_required": {
+			Type:        schema.TypeBool,
+			Description: "Whether the virtual machine reports the RestartRequired condition.",
+			Computed:    true,
+		},
+	}
+}
+
+// RestartRequired reports whether the virtual machine waits for a restart to apply changes.
+func RestartRequired(vm kubevirtapiv1.VirtualMachine) bool {
+	for _, condition := range vm.Status.Conditions {
+		if condition.Type == VirtualMachineRestartRequired {
+			return condition.Status == k8sv1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -2,10 +2,13 @@ package virtualmachine
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/virtualmachineinstance"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils/patch"
+	"k8s.io/apimachinery/pkg/api/equality"
 	kubevirtapiv1 "kubevirt.io/api/core/v1"
 )
 
@@ -30,10 +33,12 @@ func virtualMachineSpecFields() map[string]*schema.Schema {
 		},
 		"template":              virtualmachineinstance.VirtualMachineInstanceTemplateSpecSchema(),
 		"data_volume_templates": dataVolumeTemplatesSchema(),
+		"instancetype":          instancetypeMatcherSchema(),
+		"preference":            preferenceMatcherSchema(),
 	}
 }
 
-func virtualMachineSpecSchema() *schema.Schema {
+func VirtualMachineSpecSchema() *schema.Schema {
 	fields := virtualMachineSpecFields()
 
 	return &schema.Schema{
@@ -49,7 +54,7 @@ func virtualMachineSpecSchema() *schema.Schema {
 
 }
 
-func expandVirtualMachineSpec(virtualMachine []interface{}) (kubevirtapiv1.VirtualMachineSpec, error) {
+func ExpandVirtualMachineSpec(virtualMachine []interface{}) (kubevirtapiv1.VirtualMachineSpec, error) {
 	result := kubevirtapiv1.VirtualMachineSpec{}
 
 	if len(virtualMachine) == 0 || virtualMachine[0] == nil {
@@ -81,11 +86,17 @@ func expandVirtualMachineSpec(virtualMachine []interface{}) (kubevirtapiv1.Virtu
 		}
 		result.DataVolumeTemplates = dataVolumeTemplates
 	}
+	if v, ok := in["instancetype"].([]interface{}); ok {
+		result.Instancetype = expandInstancetypeMatcher(v)
+	}
+	if v, ok := in["preference"].([]interface{}); ok {
+		result.Preference = expandPreferenceMatcher(v)
+	}
 
 	return result, nil
 }
 
-func flattenVirtualMachineSpec(in kubevirtapiv1.VirtualMachineSpec) []interface{} {
+func FlattenVirtualMachineSpec(in kubevirtapiv1.VirtualMachineSpec) []interface{} {
 	att := make(map[string]interface{})
 
 	// if in.Running != nil {
@@ -98,6 +109,109 @@ func flattenVirtualMachineSpec(in kubevirtapiv1.VirtualMachineSpec) []interface{
 		att["template"] = virtualmachineinstance.FlattenVirtualMachineInstanceTemplateSpec(*in.Template)
 	}
 	att["data_volume_templates"] = flattenDataVolumeTemplates(in.DataVolumeTemplates)
+	if in.Instancetype != nil {
+		att["instancetype"] = flattenInstancetypeMatcher(*in.Instancetype)
+	}
+	if in.Preference != nil {
+		att["preference"] = flattenPreferenceMatcher(*in.Preference)
+	}
 
 	return []interface{}{att}
 }
+
+// DiffVirtualMachineSpec returns the JSON patch operations needed to turn
+// oldSpec into newSpec. Every top level field is replaced as a whole, since
+// KubeVirt validates the template as a unit anyway.
+func DiffVirtualMachineSpec(pathPrefix string, oldSpec, newSpec kubevirtapiv1.VirtualMachineSpec) patch.PatchOperations {
+	ops := make([]patch.PatchOperation, 0, 0)
+	pathPrefix = strings.TrimRight(pathPrefix, "/")
+
+	if !equality.Semantic.DeepEqual(oldSpec.RunStrategy, newSpec.RunStrategy) {
+		if newSpec.RunStrategy == nil {
+			ops = append(ops, &patch.RemoveOperation{
+				Path: pathPrefix + "/runStrategy",
+			})
+		} else {
+			ops = append(ops, &patch.AddOperation{
+				Path:  pathPrefix + "/runStrategy",
+				Value: *newSpec.RunStrategy,
+			})
+		}
+	}
+	if !equality.Semantic.DeepEqual(oldSpec.Template, newSpec.Template) {
+		if newSpec.Template == nil {
+			ops = append(ops, &patch.RemoveOperation{
+				Path: pathPrefix + "/template",
+			})
+		} else {
+			ops = append(ops, &patch.AddOperation{
+				Path:  pathPrefix + "/template",
+				Value: newSpec.Template,
+			})
+		}
+	}
+	if !equality.Semantic.DeepEqual(oldSpec.DataVolumeTemplates, newSpec.DataVolumeTemplates) {
+		if len(newSpec.DataVolumeTemplates) == 0 {
+			ops = append(ops, &patch.RemoveOperation{
+				Path: pathPrefix + "/dataVolumeTemplates",
+			})
+		} else {
+			ops = append(ops, &patch.AddOperation{
+				Path:  pathPrefix + "/dataVolumeTemplates",
+				Value: newSpec.DataVolumeTemplates,
+			})
+		}
+	}
+	newSpec.Instancetype = withoutStaleInstancetypeRevision(oldSpec.Instancetype, newSpec.Instancetype)
+	newSpec.Preference = withoutStalePreferenceRevision(oldSpec.Preference, newSpec.Preference)
+	if !equality.Semantic.DeepEqual(oldSpec.Instancetype, newSpec.Instancetype) {
+		if newSpec.Instancetype == nil {
+			ops = append(ops, &patch.RemoveOperation{
+				Path: pathPrefix + "/instancetype",
+			})
+		} else {
+			ops = append(ops, &patch.AddOperation{
+				Path:  pathPrefix + "/instancetype",
+				Value: newSpec.Instancetype,
+			})
+		}
+	}
+	if !equality.Semantic.DeepEqual(oldSpec.Preference, newSpec.Preference) {
+		if newSpec.Preference == nil {
+			ops = append(ops, &patch.RemoveOperation{
+				Path: pathPrefix + "/preference",
+			})
+		} else {
+			ops = append(ops, &patch.AddOperation{
+				Path:  pathPrefix + "/preference",
+				Value: newSpec.Preference,
+			})
+		}
+	}
+
+	return ops
+}
+
+// withoutStaleInstancetypeRevision drops the revision name the new matcher carries over from the old
+// one when it refers to another instancetype: the revision is computed, so it stays in the state
+// unless it is configured, and KubeVirt rejects a revision of another instancetype.
+func withoutStaleInstancetypeRevision(oldMatcher, newMatcher *kubevirtapiv1.InstancetypeMatcher) *kubevirtapiv1.InstancetypeMatcher {
+	if oldMatcher == nil || newMatcher == nil || newMatcher.RevisionName != oldMatcher.RevisionName ||
+		(newMatcher.Name == oldMatcher.Name && newMatcher.Kind == oldMatcher.Kind) {
+		return newMatcher
+	}
+	matcher := *newMatcher
+	matcher.RevisionName = ""
+	return &matcher
+}
+
+// withoutStalePreferenceRevision is withoutStaleInstancetypeRevision for preferences.
+func withoutStalePreferenceRevision(oldMatcher, newMatcher *kubevirtapiv1.PreferenceMatcher) *kubevirtapiv1.PreferenceMatcher {
+	if oldMatcher == nil || newMatcher == nil || newMatcher.RevisionName != oldMatcher.RevisionName ||
+		(newMatcher.Name == oldMatcher.Name && newMatcher.Kind == oldMatcher.Kind) {
+		return newMatcher
+	}
+	matcher := *newMatcher
+	matcher.RevisionName = ""
+	return &matcher
+}
@@ -4,11 +4,12 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/virtualmachineinstance"
 	kubevirtapiv1 "kubevirt.io/api/core/v1"
 )
 
 func virtualMachineStatusFields() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	fields := map[string]*schema.Schema{
 		"created": &schema.Schema{
 			Type:        schema.TypeBool,
 			Description: "Created indicates if the virtual machine is created in the cluster.",
@@ -19,9 +20,20 @@ func virtualMachineStatusFields() map[string]*schema.Schema {
 			Description: "Ready indicates if the virtual machine is running and ready.",
 			Optional:    true,
 		},
+		"printable_status": &schema.Schema{
+			Type:        schema.TypeString,
+			Description: "PrintableStatus is a human readable, high-level representation of the status of the virtual machine.",
+			Computed:    true,
+		},
 		"conditions":            virtualMachineConditionsSchema(),
 		"state_change_requests": virtualMachineStateChangeRequestsSchema(),
 	}
+
+	for k, v := range virtualmachineinstance.VirtualMachineInstanceStatusFields() {
+		fields[k] = v
+	}
+
+	return fields
 }
 
 func virtualMachineStatusSchema() *schema.Schema {
@@ -32,6 +44,7 @@ func virtualMachineStatusSchema() *schema.Schema {
 
 		Description: fmt.Sprintf("VirtualMachineStatus represents the status returned by the controller to describe how the VirtualMachine is doing."),
 		Optional:    true,
+		Computed:    true,
 		MaxItems:    1,
 		Elem: &schema.Resource{
 			Schema: fields,
@@ -69,13 +82,17 @@ func expandVirtualMachineStatus(virtualMachineStatus []interface{}) (kubevirtapi
 	return result, nil
 }
 
-func flattenVirtualMachineStatus(in kubevirtapiv1.VirtualMachineStatus) []interface{} {
+func flattenVirtualMachineStatus(in kubevirtapiv1.VirtualMachineStatus, vmi *kubevirtapiv1.VirtualMachineInstance) []interface{} {
 	att := make(map[string]interface{})
 
 	att["created"] = in.Created
 	att["ready"] = in.Ready
+	att["printable_status"] = string(in.PrintableStatus)
 	att["conditions"] = flattenVirtualMachineConditions(in.Conditions)
 	att["state_change_requests"] = flattenVirtualMachineStateChangeRequests(in.StateChangeRequests)
+	if vmi != nil {
+		virtualmachineinstance.FlattenVirtualMachineInstanceStatus(vmi.Status, att)
+	}
 
 	return []interface{}{att}
 }
@@ -3,18 +3,30 @@ package virtualmachine
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/k8s"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils"
 	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils/patch"
 	kubevirtapiv1 "kubevirt.io/api/core/v1"
 )
 
 func VirtualMachineFields() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
-		"metadata": k8s.NamespacedMetadataSchema("VirtualMachine", false),
-		"spec":     virtualMachineSpecSchema(),
-		"status":   virtualMachineStatusSchema(),
+		"metadata":    k8s.NamespacedMetadataSchema("VirtualMachine", false),
+		"spec":        VirtualMachineSpecSchema(),
+		"status":      virtualMachineStatusSchema(),
+		"wait_for":    waitForSchema(),
+		"power_state": powerStateSchema(),
 	}
 }
 
+// DataSourceVirtualMachineFields are the attributes of a virtual machine read by a data source.
+func DataSourceVirtualMachineFields() map[string]*schema.Schema {
+	fields := utils.DataSourceSchemaFromResourceSchema(VirtualMachineFields())
+	fields["metadata"] = k8s.DataSourceNamespacedMetadataSchema("VirtualMachine")
+	delete(fields, "wait_for")
+
+	return fields
+}
+
 func ExpandVirtualMachine(virtualMachine []interface{}) (*kubevirtapiv1.VirtualMachine, error) {
 	result := &kubevirtapiv1.VirtualMachine{}
 
@@ -28,7 +40,7 @@ func ExpandVirtualMachine(virtualMachine []interface{}) (*kubevirtapiv1.VirtualM
 		result.ObjectMeta = k8s.ExpandMetadata(v)
 	}
 	if v, ok := in["spec"].([]interface{}); ok {
-		spec, err := expandVirtualMachineSpec(v)
+		spec, err := ExpandVirtualMachineSpec(v)
 		if err != nil {
 			return result, err
 		}
@@ -45,12 +57,14 @@ func ExpandVirtualMachine(virtualMachine []interface{}) (*kubevirtapiv1.VirtualM
 	return result, nil
 }
 
-func FlattenVirtualMachine(in kubevirtapiv1.VirtualMachine) []interface{} {
+// FlattenVirtualMachine flattens the virtual machine along with the runtime status
+// of its virtual machine instance, if any.
+func FlattenVirtualMachine(in kubevirtapiv1.VirtualMachine, vmi *kubevirtapiv1.VirtualMachineInstance) []interface{} {
 	att := make(map[string]interface{})
 
 	att["metadata"] = k8s.FlattenMetadata(in.ObjectMeta)
-	att["spec"] = flattenVirtualMachineSpec(in.Spec)
-	att["status"] = flattenVirtualMachineStatus(in.Status)
+	att["spec"] = FlattenVirtualMachineSpec(in.Spec)
+	att["status"] = flattenVirtualMachineStatus(in.Status, vmi)
 
 	return []interface{}{att}
 }
@@ -59,7 +73,7 @@ func FromResourceData(resourceData *schema.ResourceData) (*kubevirtapiv1.Virtual
 	result := &kubevirtapiv1.VirtualMachine{}
 
 	result.ObjectMeta = k8s.ExpandMetadata(resourceData.Get("metadata").([]interface{}))
-	spec, err := expandVirtualMachineSpec(resourceData.Get("spec").([]interface{}))
+	spec, err := ExpandVirtualMachineSpec(resourceData.Get("spec").([]interface{}))
 	if err != nil {
 		return result, err
 	}
@@ -73,20 +87,40 @@ func FromResourceData(resourceData *schema.ResourceData) (*kubevirtapiv1.Virtual
 	return result, nil
 }
 
-func ToResourceData(vm kubevirtapiv1.VirtualMachine, resourceData *schema.ResourceData) error {
+func ToResourceData(vm kubevirtapiv1.VirtualMachine, vmi *kubevirtapiv1.VirtualMachineInstance, resourceData *schema.ResourceData) error {
 	if err := resourceData.Set("metadata", k8s.FlattenMetadata(vm.ObjectMeta)); err != nil {
 		return err
 	}
-	if err := resourceData.Set("spec", flattenVirtualMachineSpec(vm.Spec)); err != nil {
+	if err := resourceData.Set("spec", FlattenVirtualMachineSpec(vm.Spec)); err != nil {
 		return err
 	}
-	if err := resourceData.Set("status", flattenVirtualMachineStatus(vm.Status)); err != nil {
+	if err := resourceData.Set("status", flattenVirtualMachineStatus(vm.Status, vmi)); err != nil {
 		return err
 	}
+	if powerState := FlattenPowerState(vm.Status.PrintableStatus); powerState != "" {
+		if err := resourceData.Set("power_state", powerState); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
 
-func AppendPatchOps(keyPrefix, pathPrefix string, resourceData *schema.ResourceData, ops []patch.PatchOperation) patch.PatchOperations {
-	return k8s.AppendPatchOps(keyPrefix+"metadata.0.", pathPrefix+"/metadata/", resourceData, ops)
+func AppendPatchOps(keyPrefix, pathPrefix string, resourceData *schema.ResourceData, ops []patch.PatchOperation) (patch.PatchOperations, error) {
+	ops = k8s.AppendPatchOps(keyPrefix+"metadata.0.", pathPrefix+"/metadata/", resourceData, ops)
+
+	if resourceData.HasChange(keyPrefix + "spec") {
+		oldV, newV := resourceData.GetChange(keyPrefix + "spec")
+		oldSpec, err := ExpandVirtualMachineSpec(oldV.([]interface{}))
+		if err != nil {
+			return ops, err
+		}
+		newSpec, err := ExpandVirtualMachineSpec(newV.([]interface{}))
+		if err != nil {
+			return ops, err
+		}
+		ops = append(ops, DiffVirtualMachineSpec(pathPrefix+"/spec", oldSpec, newSpec)...)
+	}
+
+	return ops, nil
 }
@@ -0,0 +1,110 @@
+package virtualmachine
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils"
+	kubevirtapiv1 "kubevirt.io/api/core/v1"
+)
+
+// DataSourceVirtualMachineListFields are the attributes of the data source listing virtual machines.
+func DataSourceVirtualMachineListFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"namespace": {
+			Type:        schema.TypeString,
+			Description: "Namespace to list the virtual machines from, all namespaces if empty.",
+			Optional:    true,
+		},
+		"label_selector": {
+			Type:        schema.TypeString,
+			Description: "A selector to restrict the list of returned virtual machines by their labels. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#label-selectors",
+			Optional:    true,
+		},
+		"field_selector": {
+			Type:        schema.TypeString,
+			Description: "A selector to restrict the list of returned virtual machines by their fields. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/field-selectors/",
+			Optional:    true,
+		},
+		"items": virtualMachineListItemsSchema(),
+	}
+}
+
+func virtualMachineListItemsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "The virtual machines matching the selectors.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Description: "Name of the virtual machine.",
+					Computed:    true,
+				},
+				"namespace": {
+					Type:        schema.TypeString,
+					Description: "Namespace of the virtual machine.",
+					Computed:    true,
+				},
+				"labels": {
+					Type:        schema.TypeMap,
+					Description: "Labels of the virtual machine.",
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"run_strategy": {
+					Type:        schema.TypeString,
+					Description: "Run strategy of the virtual machine.",
+					Computed:    true,
+				},
+				"printable_status": {
+					Type:        schema.TypeString,
+					Description: "Human readable, high-level representation of the status of the virtual machine.",
+					Computed:    true,
+				},
+				"ip_addresses": {
+					Type:        schema.TypeList,
+					Description: "IP addresses reported by the interfaces of the running virtual machine instance.",
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+// FlattenVirtualMachineList flattens the virtual machines along with the IP addresses of
+// their running instances, found among vmis by namespace and name.
+func FlattenVirtualMachineList(vms []kubevirtapiv1.VirtualMachine, vmis []kubevirtapiv1.VirtualMachineInstance) []interface{} {
+	instances := make(map[string]*kubevirtapiv1.VirtualMachineInstance, len(vmis))
+	for i := range vmis {
+		instances[utils.BuildId(vmis[i].ObjectMeta)] = &vmis[i]
+	}
+
+	att := make([]interface{}, len(vms))
+	for i, vm := range vms {
+		c := make(map[string]interface{})
+
+		c["name"] = vm.Name
+		c["namespace"] = vm.Namespace
+		c["labels"] = utils.FlattenStringMap(vm.Labels)
+		if runStrategy, err := vm.RunStrategy(); err == nil {
+			c["run_strategy"] = string(runStrategy)
+		}
+		c["printable_status"] = string(vm.Status.PrintableStatus)
+		ipAddresses := []string{}
+		if vmi, ok := instances[utils.BuildId(vm.ObjectMeta)]; ok {
+			for _, iface := range vmi.Status.Interfaces {
+				if len(iface.IPs) > 0 {
+					ipAddresses = append(ipAddresses, iface.IPs...)
+				} else if iface.IP != "" {
+					ipAddresses = append(ipAddresses, iface.IP)
+				}
+			}
+		}
+		c["ip_addresses"] = ipAddresses
+
+		att[i] = c
+	}
+
+	return att
+}
@@ -10,6 +10,10 @@ import (
 
 	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/test_utils/expand_utils"
 	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/test_utils/flatten_utils"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils/patch"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"gotest.tools/assert"
 )
@@ -85,7 +89,7 @@ func TestExpandVirtualMachineSpec(t *testing.T) {
 			if tc.modifier != nil {
 				tc.modifier(input)
 			}
-			output, err := expandVirtualMachineSpec([]interface{}{input})
+			output, err := ExpandVirtualMachineSpec([]interface{}{input})
 
 			if tc.shouldError {
 				assert.Equal(t, tc.expectedErrorMessage, err.Error())
@@ -116,7 +120,7 @@ func TestFlattenVirtualMachineSpec(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		output := flattenVirtualMachineSpec(tc.input)
+		output := FlattenVirtualMachineSpec(tc.input)
 
 		//Some fields include terraform randomly generated params that can't be compared
 		//so we need to manually remove them
@@ -167,3 +171,431 @@ func nullifyUncomparableFields(output *[]interface{}) {
 	nodePreferredMatchFields := nodePreference["match_fields"].([]interface{})[0].(map[string]interface{})["values"]
 	test_utils.NullifySchemaSetFunction(nodePreferredMatchFields.(*schema.Set))
 }
+
+func TestDiffVirtualMachineSpec(t *testing.T) {
+	always := kubevirtapiv1.RunStrategyAlways
+	halted := kubevirtapiv1.RunStrategyHalted
+	baseSpec := expand_utils.GetBaseOutputForVirtualMachine()
+
+	resizedSpec := expand_utils.GetBaseOutputForVirtualMachine()
+	resizedSpec.Template.Spec.Domain.Resources.Requests = k8sv1.ResourceList{
+		k8sv1.ResourceMemory: resource.MustParse("4Gi"),
+	}
+
+	cases := []struct {
+		name        string
+		old         kubevirtapiv1.VirtualMachineSpec
+		new         kubevirtapiv1.VirtualMachineSpec
+		expectedOps patch.PatchOperations
+	}{
+		{
+			name:        "no changes",
+			old:         baseSpec,
+			new:         baseSpec,
+			expectedOps: []patch.PatchOperation{},
+		},
+		{
+			name: "run strategy changed",
+			old:  kubevirtapiv1.VirtualMachineSpec{RunStrategy: &always},
+			new:  kubevirtapiv1.VirtualMachineSpec{RunStrategy: &halted},
+			expectedOps: []patch.PatchOperation{
+				&patch.AddOperation{
+					Path:  "/spec/runStrategy",
+					Value: halted,
+				},
+			},
+		},
+		{
+			name: "run strategy removed",
+			old:  kubevirtapiv1.VirtualMachineSpec{RunStrategy: &always},
+			new:  kubevirtapiv1.VirtualMachineSpec{},
+			expectedOps: []patch.PatchOperation{
+				&patch.RemoveOperation{
+					Path: "/spec/runStrategy",
+				},
+			},
+		},
+		{
+			name: "template resources changed",
+			old:  baseSpec,
+			new:  resizedSpec,
+			expectedOps: []patch.PatchOperation{
+				&patch.AddOperation{
+					Path:  "/spec/template",
+					Value: resizedSpec.Template,
+				},
+			},
+		},
+		{
+			name: "data volume templates removed",
+			old:  baseSpec,
+			new: kubevirtapiv1.VirtualMachineSpec{
+				RunStrategy: baseSpec.RunStrategy,
+				Template:    baseSpec.Template,
+			},
+			expectedOps: []patch.PatchOperation{
+				&patch.RemoveOperation{
+					Path: "/spec/dataVolumeTemplates",
+				},
+			},
+		},
+		{
+			name: "instancetype changed",
+			old: kubevirtapiv1.VirtualMachineSpec{
+				Instancetype: &kubevirtapiv1.InstancetypeMatcher{Name: "u1.small", Kind: "VirtualMachineClusterInstancetype"},
+			},
+			new: kubevirtapiv1.VirtualMachineSpec{
+				Instancetype: &kubevirtapiv1.InstancetypeMatcher{Name: "u1.large", Kind: "VirtualMachineClusterInstancetype"},
+			},
+			expectedOps: []patch.PatchOperation{
+				&patch.AddOperation{
+					Path:  "/spec/instancetype",
+					Value: &kubevirtapiv1.InstancetypeMatcher{Name: "u1.large", Kind: "VirtualMachineClusterInstancetype"},
+				},
+			},
+		},
+		{
+			name: "instancetype changed with the revision of the previous one",
+			old: kubevirtapiv1.VirtualMachineSpec{
+				Instancetype: &kubevirtapiv1.InstancetypeMatcher{Name: "u1.small", Kind: "VirtualMachineClusterInstancetype", RevisionName: "vm-u1.small-1"},
+			},
+			new: kubevirtapiv1.VirtualMachineSpec{
+				Instancetype: &kubevirtapiv1.InstancetypeMatcher{Name: "u1.large", Kind: "VirtualMachineClusterInstancetype", RevisionName: "vm-u1.small-1"},
+			},
+			expectedOps: []patch.PatchOperation{
+				&patch.AddOperation{
+					Path:  "/spec/instancetype",
+					Value: &kubevirtapiv1.InstancetypeMatcher{Name: "u1.large", Kind: "VirtualMachineClusterInstancetype"},
+				},
+			},
+		},
+		{
+			name: "instancetype changed with its revision",
+			old: kubevirtapiv1.VirtualMachineSpec{
+				Instancetype: &kubevirtapiv1.InstancetypeMatcher{Name: "u1.small", Kind: "VirtualMachineClusterInstancetype", RevisionName: "vm-u1.small-1"},
+			},
+			new: kubevirtapiv1.VirtualMachineSpec{
+				Instancetype: &kubevirtapiv1.InstancetypeMatcher{Name: "u1.large", Kind: "VirtualMachineClusterInstancetype", RevisionName: "vm-u1.large-1"},
+			},
+			expectedOps: []patch.PatchOperation{
+				&patch.AddOperation{
+					Path:  "/spec/instancetype",
+					Value: &kubevirtapiv1.InstancetypeMatcher{Name: "u1.large", Kind: "VirtualMachineClusterInstancetype", RevisionName: "vm-u1.large-1"},
+				},
+			},
+		},
+		{
+			name: "preference kind changed with the revision of the previous one",
+			old: kubevirtapiv1.VirtualMachineSpec{
+				Preference: &kubevirtapiv1.PreferenceMatcher{Name: "fedora", Kind: "VirtualMachineClusterPreference", RevisionName: "vm-fedora-1"},
+			},
+			new: kubevirtapiv1.VirtualMachineSpec{
+				Preference: &kubevirtapiv1.PreferenceMatcher{Name: "fedora", Kind: "VirtualMachinePreference", RevisionName: "vm-fedora-1"},
+			},
+			expectedOps: []patch.PatchOperation{
+				&patch.AddOperation{
+					Path:  "/spec/preference",
+					Value: &kubevirtapiv1.PreferenceMatcher{Name: "fedora", Kind: "VirtualMachinePreference"},
+				},
+			},
+		},
+		{
+			name: "preference removed",
+			old: kubevirtapiv1.VirtualMachineSpec{
+				Preference: &kubevirtapiv1.PreferenceMatcher{Name: "fedora", Kind: "VirtualMachineClusterPreference"},
+			},
+			new: kubevirtapiv1.VirtualMachineSpec{},
+			expectedOps: []patch.PatchOperation{
+				&patch.RemoveOperation{
+					Path: "/spec/preference",
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ops := DiffVirtualMachineSpec("/spec/", tc.old, tc.new)
+			if !tc.expectedOps.Equal(ops) {
+				t.Fatalf("Operations don't match.\nExpected: %v\nGiven:    %v\n", tc.expectedOps, ops)
+			}
+		})
+	}
+}
+
+func TestExpandFlattenMatchers(t *testing.T) {
+	spec := kubevirtapiv1.VirtualMachineSpec{
+		Instancetype: &kubevirtapiv1.InstancetypeMatcher{
+			Name:         "u1.medium",
+			Kind:         "VirtualMachineClusterInstancetype",
+			RevisionName: "vm-u1.medium-1",
+		},
+		Preference: &kubevirtapiv1.PreferenceMatcher{
+			InferFromVolume: "rootdisk",
+		},
+		DataVolumeTemplates: []kubevirtapiv1.DataVolumeTemplateSpec{},
+	}
+
+	flattened := FlattenVirtualMachineSpec(spec)
+	assert.DeepEqual(t, flattened[0].(map[string]interface{})["instancetype"], []interface{}{
+		map[string]interface{}{
+			"name":              "u1.medium",
+			"kind":              "VirtualMachineClusterInstancetype",
+			"revision_name":     "vm-u1.medium-1",
+			"infer_from_volume": "",
+		},
+	})
+
+	expanded, err := ExpandVirtualMachineSpec(flattened)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, expanded, spec)
+}
+
+func TestExpandWaitFor(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    []interface{}
+		expected *WaitFor
+	}{
+		{
+			name:     "not set",
+			input:    []interface{}{},
+			expected: nil,
+		},
+		{
+			name:     "ready",
+			input:    []interface{}{map[string]interface{}{"condition": "Ready", "interface": ""}},
+			expected: &WaitFor{Condition: WaitForReady},
+		},
+		{
+			name:     "none",
+			input:    []interface{}{map[string]interface{}{"condition": "None", "interface": ""}},
+			expected: &WaitFor{Condition: WaitForNone},
+		},
+		{
+			name:     "ip address of an interface",
+			input:    []interface{}{map[string]interface{}{"condition": "IPAddress", "interface": "default"}},
+			expected: &WaitFor{Condition: WaitForIPAddress, Interface: "default"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.DeepEqual(t, ExpandWaitFor(tc.input), tc.expected)
+		})
+	}
+}
+
+func TestFlattenVirtualMachineStatus(t *testing.T) {
+	status := kubevirtapiv1.VirtualMachineStatus{
+		Created:         true,
+		Ready:           true,
+		PrintableStatus: kubevirtapiv1.VirtualMachineStatusRunning,
+	}
+	migrationPolicyName := "fast"
+	vmi := &kubevirtapiv1.VirtualMachineInstance{
+		Status: kubevirtapiv1.VirtualMachineInstanceStatus{
+			Phase:           kubevirtapiv1.Running,
+			NodeName:        "node01",
+			MigrationMethod: kubevirtapiv1.BlockMigration,
+			Conditions: []kubevirtapiv1.VirtualMachineInstanceCondition{
+				{
+					Type:    kubevirtapiv1.VirtualMachineInstanceIsMigratable,
+					Status:  k8sv1.ConditionFalse,
+					Reason:  kubevirtapiv1.VirtualMachineInstanceReasonDisksNotMigratable,
+					Message: "cannot migrate VMI: PVC rootdisk is not shared, live migration requires that all PVCs must be shared (using ReadWriteMany access mode)",
+				},
+			},
+			Interfaces: []kubevirtapiv1.VirtualMachineInstanceNetworkInterface{
+				{
+					Name: "default",
+					IP:   "10.0.2.2",
+					IPs:  []string{"10.0.2.2", "fd10:0:2::2"},
+					MAC:  "52:54:00:12:34:56",
+				},
+			},
+			MigrationState: &kubevirtapiv1.VirtualMachineInstanceMigrationState{
+				SourceNode:          "node02",
+				TargetNode:          "node01",
+				Completed:           true,
+				MigrationPolicyName: &migrationPolicyName,
+			},
+			GuestOSInfo: kubevirtapiv1.VirtualMachineInstanceGuestOSInfo{
+				ID:   "fedora",
+				Name: "Fedora Linux",
+			},
+		},
+	}
+
+	cases := []struct {
+		name     string
+		vmi      *kubevirtapiv1.VirtualMachineInstance
+		expected map[string]interface{}
+	}{
+		{
+			name: "no instance",
+			vmi:  nil,
+			expected: map[string]interface{}{
+				"created":               true,
+				"ready":                 true,
+				"printable_status":      "Running",
+				"conditions":            []interface{}{},
+				"state_change_requests": []interface{}{},
+			},
+		},
+		{
+			name: "running instance",
+			vmi:  vmi,
+			expected: map[string]interface{}{
+				"created":                 true,
+				"ready":                   true,
+				"printable_status":        "Running",
+				"conditions":              []interface{}{},
+				"state_change_requests":   []interface{}{},
+				"phase":                   "Running",
+				"node_name":               "node01",
+				"migration_method":        "BlockMigration",
+				"live_migratable":         false,
+				"live_migratable_reason":  "DisksNotLiveMigratable",
+				"live_migratable_message": "cannot migrate VMI: PVC rootdisk is not shared, live migration requires that all PVCs must be shared (using ReadWriteMany access mode)",
+				"migration_policy_name":   "fast",
+				"migration_state": []interface{}{
+					map[string]interface{}{
+						"start_timestamp":       "",
+						"end_timestamp":         "",
+						"source_node":           "node02",
+						"target_node":           "node01",
+						"completed":             true,
+						"failed":                false,
+						"abort_status":          "",
+						"mode":                  "",
+						"migration_uid":         "",
+						"migration_policy_name": "fast",
+					},
+				},
+				"interfaces": []interface{}{
+					map[string]interface{}{
+						"name":         "default",
+						"ip_address":   "10.0.2.2",
+						"ip_addresses": []string{"10.0.2.2", "fd10:0:2::2"},
+						"mac":          "52:54:00:12:34:56",
+					},
+				},
+				"guest_os_info": []interface{}{
+					map[string]interface{}{
+						"name":           "Fedora Linux",
+						"id":             "fedora",
+						"pretty_name":    "",
+						"version":        "",
+						"version_id":     "",
+						"kernel_release": "",
+						"kernel_version": "",
+						"machine":        "",
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			output := flattenVirtualMachineStatus(status, tc.vmi)
+			assert.DeepEqual(t, output, []interface{}{tc.expected})
+		})
+	}
+}
+
+func TestFlattenVirtualMachineList(t *testing.T) {
+	always := kubevirtapiv1.RunStrategyAlways
+	halted := kubevirtapiv1.RunStrategyHalted
+
+	vms := []kubevirtapiv1.VirtualMachine{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-0", Namespace: "prod", Labels: map[string]string{"role": "web"}},
+			Spec:       kubevirtapiv1.VirtualMachineSpec{RunStrategy: &always},
+			Status:     kubevirtapiv1.VirtualMachineStatus{PrintableStatus: kubevirtapiv1.VirtualMachineStatusRunning},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "prod"},
+			Spec:       kubevirtapiv1.VirtualMachineSpec{RunStrategy: &halted},
+			Status:     kubevirtapiv1.VirtualMachineStatus{PrintableStatus: kubevirtapiv1.VirtualMachineStatusStopped},
+		},
+	}
+	vmis := []kubevirtapiv1.VirtualMachineInstance{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-0", Namespace: "prod"},
+			Status: kubevirtapiv1.VirtualMachineInstanceStatus{
+				Interfaces: []kubevirtapiv1.VirtualMachineInstanceNetworkInterface{
+					{Name: "default", IP: "10.0.2.2", IPs: []string{"10.0.2.2", "fd10:0:2::2"}},
+					{Name: "secondary", IP: "192.168.1.10"},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "staging"},
+			Status: kubevirtapiv1.VirtualMachineInstanceStatus{
+				Interfaces: []kubevirtapiv1.VirtualMachineInstanceNetworkInterface{
+					{Name: "default", IP: "10.0.2.3"},
+				},
+			},
+		},
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"name":             "web-0",
+			"namespace":        "prod",
+			"labels":           map[string]interface{}{"role": "web"},
+			"run_strategy":     "Always",
+			"printable_status": "Running",
+			"ip_addresses":     []string{"10.0.2.2", "fd10:0:2::2", "192.168.1.10"},
+		},
+		map[string]interface{}{
+			"name":             "web-1",
+			"namespace":        "prod",
+			"labels":           map[string]interface{}(nil),
+			"run_strategy":     "Halted",
+			"printable_status": "Stopped",
+			"ip_addresses":     []string{},
+		},
+	}
+
+	assert.DeepEqual(t, FlattenVirtualMachineList(vms, vmis), expected)
+}
+
+func TestRestartRequired(t *testing.T) {
+	cases := []struct {
+		name       string
+		conditions []kubevirtapiv1.VirtualMachineCondition
+		expected   bool
+	}{
+		{
+			name:     "no conditions",
+			expected: false,
+		},
+		{
+			name: "restart required",
+			conditions: []kubevirtapiv1.VirtualMachineCondition{
+				{Type: kubevirtapiv1.VirtualMachineReady, Status: k8sv1.ConditionTrue},
+				{Type: VirtualMachineRestartRequired, Status: k8sv1.ConditionTrue},
+			},
+			expected: true,
+		},
+		{
+			name: "restart not required",
+			conditions: []kubevirtapiv1.VirtualMachineCondition{
+				{Type: VirtualMachineRestartRequired, Status: k8sv1.ConditionFalse},
+			},
+			expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			vm := kubevirtapiv1.VirtualMachine{
+				Status: kubevirtapiv1.VirtualMachineStatus{Conditions: tc.conditions},
+			}
+			assert.Equal(t, RestartRequired(vm), tc.expected)
+		})
+	}
+}
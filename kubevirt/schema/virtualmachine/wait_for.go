@@ -0,0 +1,81 @@
+package virtualmachine
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	// WaitForReady waits for the Ready condition of the virtual machine.
+	WaitForReady = "Ready"
+	// WaitForRunning waits for the virtual machine instance to reach phase Running.
+	WaitForRunning = "Running"
+	// WaitForAgentConnected waits for the AgentConnected condition of the virtual machine instance.
+	WaitForAgentConnected = "AgentConnected"
+	// WaitForIPAddress waits for an interface of the virtual machine instance to report an IP address.
+	WaitForIPAddress = "IPAddress"
+	// WaitForNone doesn't wait at all once the virtual machine is accepted.
+	WaitForNone = "None"
+)
+
+// WaitFor describes what the virtual machine has to reach before its creation is complete.
+type WaitFor struct {
+	Condition string
+	Interface string
+}
+
+func waitForFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"condition": {
+			Type:        schema.TypeString,
+			Description: "What the virtual machine has to reach before it is considered created: \"Ready\", \"Running\", \"AgentConnected\", \"IPAddress\" or \"None\". Virtual machines created stopped can only wait for \"None\".",
+			Required:    true,
+			ValidateFunc: validation.StringInSlice([]string{
+				WaitForReady,
+				WaitForRunning,
+				WaitForAgentConnected,
+				WaitForIPAddress,
+				WaitForNone,
+			}, false),
+		},
+		"interface": {
+			Type:        schema.TypeString,
+			Description: "Name of the interface which has to report an IP address when condition is \"IPAddress\", any interface if empty.",
+			Optional:    true,
+		},
+	}
+}
+
+func waitForSchema() *schema.Schema {
+	fields := waitForFields()
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: fmt.Sprintf("WaitFor defines what the creation waits for, by default running virtual machines wait to be ready and stopped ones for their data volumes."),
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+func ExpandWaitFor(waitFor []interface{}) *WaitFor {
+	if len(waitFor) == 0 || waitFor[0] == nil {
+		return nil
+	}
+
+	result := &WaitFor{}
+	in := waitFor[0].(map[string]interface{})
+
+	if v, ok := in["condition"].(string); ok {
+		result.Condition = v
+	}
+	if v, ok := in["interface"].(string); ok {
+		result.Interface = v
+	}
+
+	return result
+}
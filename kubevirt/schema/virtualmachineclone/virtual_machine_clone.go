@@ -0,0 +1,226 @@
+package virtualmachineclone
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/k8s"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils"
+	clonev1alpha1 "kubevirt.io/api/clone/v1alpha1"
+)
+
+func VirtualMachineCloneFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"metadata": k8s.NamespacedMetadataSchema("VirtualMachineClone", true),
+		"spec":     virtualMachineCloneSpecSchema(),
+		"status":   virtualMachineCloneStatusSchema(),
+	}
+}
+
+func virtualMachineCloneSpecFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"source": k8s.TypedLocalObjectReferenceSchema("Source is the virtual machine, or the VirtualMachineSnapshot of the \"snapshot.kubevirt.io\" API group, to clone.", true),
+		"target": virtualMachineCloneTargetSchema(),
+		"annotation_filters": {
+			Type:        schema.TypeList,
+			Description: "Filters of the annotations copied to the target, such as \"*\" or \"!someKey/*\".",
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"label_filters": {
+			Type:        schema.TypeList,
+			Description: "Filters of the labels copied to the target, such as \"*\" or \"!someKey/*\".",
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"new_mac_addresses": {
+			Type:        schema.TypeMap,
+			Description: "MAC addresses of the target interfaces, by interface name. Interfaces left out get a new generated MAC address.",
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"new_smbios_serial": {
+			Type:        schema.TypeString,
+			Description: "SMBIOS serial of the target, a new one is generated when omitted.",
+			Optional:    true,
+		},
+	}
+}
+
+// virtualMachineCloneTargetSchema is computed, KubeVirt sets the target it generates
+// when it is omitted.
+func virtualMachineCloneTargetSchema() *schema.Schema {
+	target := k8s.TypedLocalObjectReferenceSchema("Target is the virtual machine to create, a random name is generated when omitted.", false)
+	target.Computed = true
+
+	return target
+}
+
+func virtualMachineCloneSpecSchema() *schema.Schema {
+	fields := virtualMachineCloneSpecFields()
+
+	return &schema.Schema{
+		Type: schema.TypeList,
+
+		Description: fmt.Sprintf("VirtualMachineCloneSpec is the spec for a VirtualMachineClone resource."),
+		Required:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+func virtualMachineCloneStatusFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"phase": {
+			Type:        schema.TypeString,
+			Description: "Phase of the clone.",
+			Computed:    true,
+		},
+		"creation_time": {
+			Type:        schema.TypeString,
+			Description: "Time the clone was created.",
+			Computed:    true,
+		},
+		"conditions": k8s.ConditionsSchema(),
+		"snapshot_name": {
+			Type:        schema.TypeString,
+			Description: "Name of the VirtualMachineSnapshot taken of the source.",
+			Computed:    true,
+		},
+		"restore_name": {
+			Type:        schema.TypeString,
+			Description: "Name of the VirtualMachineRestore creating the target.",
+			Computed:    true,
+		},
+		"target_name": {
+			Type:        schema.TypeString,
+			Description: "Name of the virtual machine created by the clone.",
+			Computed:    true,
+		},
+	}
+}
+
+func virtualMachineCloneStatusSchema() *schema.Schema {
+	fields := virtualMachineCloneStatusFields()
+
+	return &schema.Schema{
+		Type: schema.TypeList,
+
+		Description: fmt.Sprintf("VirtualMachineCloneStatus is the status for a VirtualMachineClone resource."),
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+func expandVirtualMachineCloneSpec(virtualMachineCloneSpec []interface{}) clonev1alpha1.VirtualMachineCloneSpec {
+	result := clonev1alpha1.VirtualMachineCloneSpec{}
+
+	if len(virtualMachineCloneSpec) == 0 || virtualMachineCloneSpec[0] == nil {
+		return result
+	}
+
+	in := virtualMachineCloneSpec[0].(map[string]interface{})
+
+	if v, ok := in["source"].([]interface{}); ok {
+		result.Source = k8s.ExpandTypedLocalObjectReferencePtr(v)
+	}
+	if v, ok := in["target"].([]interface{}); ok {
+		result.Target = k8s.ExpandTypedLocalObjectReferencePtr(v)
+	}
+	if v, ok := in["annotation_filters"].([]interface{}); ok && len(v) > 0 {
+		result.AnnotationFilters = utils.ExpandStringSlice(v)
+	}
+	if v, ok := in["label_filters"].([]interface{}); ok && len(v) > 0 {
+		result.LabelFilters = utils.ExpandStringSlice(v)
+	}
+	if v, ok := in["new_mac_addresses"].(map[string]interface{}); ok && len(v) > 0 {
+		result.NewMacAddresses = utils.ExpandStringMap(v)
+	}
+	if v, ok := in["new_smbios_serial"].(string); ok && v != "" {
+		result.NewSMBiosSerial = &v
+	}
+
+	return result
+}
+
+func flattenVirtualMachineCloneSpec(in clonev1alpha1.VirtualMachineCloneSpec) []interface{} {
+	att := make(map[string]interface{})
+
+	if in.Source != nil {
+		att["source"] = k8s.FlattenTypedLocalObjectReference(*in.Source)
+	}
+	if in.Target != nil {
+		att["target"] = k8s.FlattenTypedLocalObjectReference(*in.Target)
+	}
+	att["annotation_filters"] = in.AnnotationFilters
+	att["label_filters"] = in.LabelFilters
+	att["new_mac_addresses"] = in.NewMacAddresses
+	if in.NewSMBiosSerial != nil {
+		att["new_smbios_serial"] = *in.NewSMBiosSerial
+	}
+
+	return []interface{}{att}
+}
+
+func flattenVirtualMachineCloneStatus(in clonev1alpha1.VirtualMachineCloneStatus) []interface{} {
+	att := make(map[string]interface{})
+
+	att["phase"] = string(in.Phase)
+	att["creation_time"] = utils.FlattenTime(in.CreationTime)
+	att["conditions"] = flattenConditions(in.Conditions)
+	if in.SnapshotName != nil {
+		att["snapshot_name"] = *in.SnapshotName
+	}
+	if in.RestoreName != nil {
+		att["restore_name"] = *in.RestoreName
+	}
+	if in.TargetName != nil {
+		att["target_name"] = *in.TargetName
+	}
+
+	return []interface{}{att}
+}
+
+func flattenConditions(in []clonev1alpha1.Condition) []interface{} {
+	att := make([]interface{}, len(in))
+
+	for i, v := range in {
+		c := make(map[string]interface{})
+
+		c["type"] = string(v.Type)
+		c["status"] = string(v.Status)
+		c["reason"] = v.Reason
+		c["message"] = v.Message
+
+		att[i] = c
+	}
+
+	return att
+}
+
+func FromResourceData(resourceData *schema.ResourceData) *clonev1alpha1.VirtualMachineClone {
+	result := &clonev1alpha1.VirtualMachineClone{}
+
+	result.ObjectMeta = k8s.ExpandMetadata(resourceData.Get("metadata").([]interface{}))
+	result.Spec = expandVirtualMachineCloneSpec(resourceData.Get("spec").([]interface{}))
+
+	return result
+}
+
+func ToResourceData(clone clonev1alpha1.VirtualMachineClone, resourceData *schema.ResourceData) error {
+	if err := resourceData.Set("metadata", k8s.FlattenMetadata(clone.ObjectMeta)); err != nil {
+		return err
+	}
+	if err := resourceData.Set("spec", flattenVirtualMachineCloneSpec(clone.Spec)); err != nil {
+		return err
+	}
+	if err := resourceData.Set("status", flattenVirtualMachineCloneStatus(clone.Status)); err != nil {
+		return err
+	}
+
+	return nil
+}
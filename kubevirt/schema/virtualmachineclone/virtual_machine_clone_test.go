@@ -0,0 +1,116 @@
+package virtualmachineclone
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clonev1alpha1 "kubevirt.io/api/clone/v1alpha1"
+
+	"gotest.tools/assert"
+)
+
+func TestExpandFlattenVirtualMachineCloneSpec(t *testing.T) {
+	apiGroup := "kubevirt.io"
+	serial := "e3b0c442-98fc-1c14"
+
+	spec := clonev1alpha1.VirtualMachineCloneSpec{
+		Source: &k8sv1.TypedLocalObjectReference{
+			APIGroup: &apiGroup,
+			Kind:     "VirtualMachine",
+			Name:     "template-vm",
+		},
+		Target: &k8sv1.TypedLocalObjectReference{
+			APIGroup: &apiGroup,
+			Kind:     "VirtualMachine",
+			Name:     "cloned-vm",
+		},
+		AnnotationFilters: []string{"*"},
+		LabelFilters:      []string{"*", "!template/*"},
+		NewMacAddresses:   map[string]string{"default": "02:00:00:00:00:01"},
+		NewSMBiosSerial:   &serial,
+	}
+
+	flattened := flattenVirtualMachineCloneSpec(spec)
+	assert.DeepEqual(t, flattened, []interface{}{
+		map[string]interface{}{
+			"source": []interface{}{
+				map[string]interface{}{
+					"api_group": "kubevirt.io",
+					"kind":      "VirtualMachine",
+					"name":      "template-vm",
+				},
+			},
+			"target": []interface{}{
+				map[string]interface{}{
+					"api_group": "kubevirt.io",
+					"kind":      "VirtualMachine",
+					"name":      "cloned-vm",
+				},
+			},
+			"annotation_filters": []string{"*"},
+			"label_filters":      []string{"*", "!template/*"},
+			"new_mac_addresses":  map[string]string{"default": "02:00:00:00:00:01"},
+			"new_smbios_serial":  "e3b0c442-98fc-1c14",
+		},
+	})
+}
+
+func TestFlattenVirtualMachineCloneStatus(t *testing.T) {
+	target := "cloned-vm"
+
+	status := clonev1alpha1.VirtualMachineCloneStatus{
+		Phase:      clonev1alpha1.Succeeded,
+		TargetName: &target,
+	}
+
+	assert.DeepEqual(t, flattenVirtualMachineCloneStatus(status), []interface{}{
+		map[string]interface{}{
+			"phase":         "Succeeded",
+			"creation_time": "",
+			"conditions":    []interface{}{},
+			"target_name":   "cloned-vm",
+		},
+	})
+}
+
+func TestVirtualMachineCloneGeneratedTargetDiff(t *testing.T) {
+	r := &schema.Resource{Schema: VirtualMachineCloneFields()}
+	apiGroup := "kubevirt.io"
+
+	// The clone as read back, with the target KubeVirt generated.
+	clone := clonev1alpha1.VirtualMachineClone{
+		ObjectMeta: metav1.ObjectMeta{Name: "clone", Namespace: "default"},
+		Spec: clonev1alpha1.VirtualMachineCloneSpec{
+			Source: &k8sv1.TypedLocalObjectReference{APIGroup: &apiGroup, Kind: "VirtualMachine", Name: "template-vm"},
+			Target: &k8sv1.TypedLocalObjectReference{APIGroup: &apiGroup, Kind: "VirtualMachine", Name: "clone-vm-x7k2p"},
+		},
+	}
+	resourceData := r.TestResourceData()
+	assert.NilError(t, ToResourceData(clone, resourceData))
+	resourceData.SetId("default/clone")
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"metadata": []interface{}{map[string]interface{}{
+			"name":      "clone",
+			"namespace": "default",
+		}},
+		"spec": []interface{}{map[string]interface{}{
+			"source": []interface{}{map[string]interface{}{
+				"kind": "VirtualMachine",
+				"name": "template-vm",
+			}},
+		}},
+	})
+
+	diff, err := r.Diff(context.Background(), resourceData.State(), config, nil)
+	assert.NilError(t, err)
+	if diff != nil {
+		for k, v := range diff.Attributes {
+			t.Errorf("unexpected change of %s: %#v", k, v)
+		}
+	}
+}
@@ -0,0 +1,349 @@
+package virtualmachineexport
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/k8s"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils/patch"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	exportv1alpha1 "kubevirt.io/api/export/v1alpha1"
+)
+
+func VirtualMachineExportFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"metadata": k8s.NamespacedMetadataSchema("VirtualMachineExport", true),
+		"spec":     virtualMachineExportSpecSchema(),
+		"status":   virtualMachineExportStatusSchema(),
+	}
+}
+
+func virtualMachineExportSpecFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"source": {
+			Type:        schema.TypeList,
+			Description: "Source is the virtual machine, VirtualMachineSnapshot or PersistentVolumeClaim to export.",
+			Required:    true,
+			ForceNew:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"api_group": {
+						Type:        schema.TypeString,
+						Description: "APIGroup of the source: \"kubevirt.io\" for a virtual machine, \"snapshot.kubevirt.io\" for a snapshot and empty for a PersistentVolumeClaim.",
+						Optional:    true,
+						ForceNew:    true,
+					},
+					"kind": {
+						Type:        schema.TypeString,
+						Description: "Kind of the source: \"VirtualMachine\", \"VirtualMachineSnapshot\" or \"PersistentVolumeClaim\".",
+						Required:    true,
+						ForceNew:    true,
+						ValidateFunc: validation.StringInSlice([]string{
+							"VirtualMachine",
+							"VirtualMachineSnapshot",
+							"PersistentVolumeClaim",
+						}, false),
+					},
+					"name": {
+						Type:         schema.TypeString,
+						Description:  "Name of the source.",
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: utils.ValidateName,
+					},
+				},
+			},
+		},
+		"token_secret_ref": {
+			Type:         schema.TypeString,
+			Description:  "Name of the secret holding the token the export server requires to serve the links.",
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: utils.ValidateName,
+		},
+		"ttl_duration": {
+			Type:             schema.TypeString,
+			Description:      "Duration, such as \"2h\", after which the export is terminated.",
+			Optional:         true,
+			ForceNew:         true,
+			ValidateFunc:     utils.ValidateDuration,
+			DiffSuppressFunc: utils.SuppressEquivalentDuration,
+		},
+	}
+}
+
+func virtualMachineExportSpecSchema() *schema.Schema {
+	fields := virtualMachineExportSpecFields()
+
+	return &schema.Schema{
+		Type: schema.TypeList,
+
+		Description: fmt.Sprintf("VirtualMachineExportSpec is the spec for a VirtualMachineExport resource."),
+		Required:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+func virtualMachineExportStatusFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"phase": {
+			Type:        schema.TypeString,
+			Description: "Phase of the export: \"Pending\", \"Ready\", \"Terminated\" or \"Skipped\".",
+			Computed:    true,
+		},
+		"token_secret_ref": {
+			Type:        schema.TypeString,
+			Description: "Name of the secret holding the token used by the export server.",
+			Computed:    true,
+		},
+		"ttl_expiration_time": {
+			Type:        schema.TypeString,
+			Description: "Time the export is terminated at.",
+			Computed:    true,
+		},
+		"service_name": {
+			Type:        schema.TypeString,
+			Description: "Name of the service of the export server.",
+			Computed:    true,
+		},
+		"conditions":    k8s.ConditionsSchema(),
+		"internal_link": exportLinkSchema("Links to download the export from inside the cluster."),
+		"external_link": exportLinkSchema("Links to download the export from outside the cluster, through its ingress or route."),
+	}
+}
+
+func virtualMachineExportStatusSchema() *schema.Schema {
+	fields := virtualMachineExportStatusFields()
+
+	return &schema.Schema{
+		Type: schema.TypeList,
+
+		Description: fmt.Sprintf("VirtualMachineExportStatus is the status for a VirtualMachineExport resource."),
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+func exportLinkSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cert": {
+					Type:        schema.TypeString,
+					Description: "PEM encoded certificate to trust when downloading from the links.",
+					Computed:    true,
+				},
+				"volumes": {
+					Type:        schema.TypeList,
+					Description: "Links of each exported volume.",
+					Computed:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:        schema.TypeString,
+								Description: "Name of the volume.",
+								Computed:    true,
+							},
+							"formats": {
+								Type:        schema.TypeList,
+								Description: "Link of the volume in each available format.",
+								Computed:    true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"format": {
+											Type:        schema.TypeString,
+											Description: "Format of the volume: \"raw\", \"gzip\", \"dir\" or \"tar.gz\".",
+											Computed:    true,
+										},
+										"url": {
+											Type:        schema.TypeString,
+											Description: "URL to download the volume in this format from.",
+											Computed:    true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"manifests": {
+					Type:        schema.TypeList,
+					Description: "Links to the manifests generated to recreate the virtual machine.",
+					Computed:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"type": {
+								Type:        schema.TypeString,
+								Description: "Type of the manifests: \"all\" or \"auth-header-secret\".",
+								Computed:    true,
+							},
+							"url": {
+								Type:        schema.TypeString,
+								Description: "URL to download the manifests from.",
+								Computed:    true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineExportSpec(virtualMachineExportSpec []interface{}) (exportv1alpha1.VirtualMachineExportSpec, error) {
+	result := exportv1alpha1.VirtualMachineExportSpec{}
+
+	if len(virtualMachineExportSpec) == 0 || virtualMachineExportSpec[0] == nil {
+		return result, nil
+	}
+
+	in := virtualMachineExportSpec[0].(map[string]interface{})
+
+	if v, ok := in["source"].([]interface{}); ok {
+		result.Source = k8s.ExpandTypedLocalObjectReference(v)
+	}
+	if v, ok := in["token_secret_ref"].(string); ok && v != "" {
+		result.TokenSecretRef = &v
+	}
+	if v, ok := in["ttl_duration"].(string); ok && v != "" {
+		ttl, err := time.ParseDuration(v)
+		if err != nil {
+			return result, err
+		}
+		result.TTLDuration = &metav1.Duration{Duration: ttl}
+	}
+
+	return result, nil
+}
+
+func flattenVirtualMachineExportSpec(in exportv1alpha1.VirtualMachineExportSpec) []interface{} {
+	att := make(map[string]interface{})
+
+	att["source"] = k8s.FlattenTypedLocalObjectReference(in.Source)
+	if in.TokenSecretRef != nil {
+		att["token_secret_ref"] = *in.TokenSecretRef
+	}
+	if in.TTLDuration != nil {
+		att["ttl_duration"] = in.TTLDuration.Duration.String()
+	}
+
+	return []interface{}{att}
+}
+
+func flattenVirtualMachineExportStatus(in *exportv1alpha1.VirtualMachineExportStatus) []interface{} {
+	att := make(map[string]interface{})
+
+	if in != nil {
+		att["phase"] = string(in.Phase)
+		if in.TokenSecretRef != nil {
+			att["token_secret_ref"] = *in.TokenSecretRef
+		}
+		att["ttl_expiration_time"] = utils.FlattenTime(in.TTLExpirationTime)
+		att["service_name"] = in.ServiceName
+		att["conditions"] = flattenConditions(in.Conditions)
+		if in.Links != nil {
+			if in.Links.Internal != nil {
+				att["internal_link"] = flattenExportLink(*in.Links.Internal)
+			}
+			if in.Links.External != nil {
+				att["external_link"] = flattenExportLink(*in.Links.External)
+			}
+		}
+	}
+
+	return []interface{}{att}
+}
+
+func flattenConditions(in []exportv1alpha1.Condition) []interface{} {
+	att := make([]interface{}, len(in))
+
+	for i, v := range in {
+		c := make(map[string]interface{})
+
+		c["type"] = string(v.Type)
+		c["status"] = string(v.Status)
+		c["reason"] = v.Reason
+		c["message"] = v.Message
+
+		att[i] = c
+	}
+
+	return att
+}
+
+func flattenExportLink(in exportv1alpha1.VirtualMachineExportLink) []interface{} {
+	att := make(map[string]interface{})
+
+	att["cert"] = in.Cert
+
+	volumes := make([]interface{}, len(in.Volumes))
+	for i, volume := range in.Volumes {
+		formats := make([]interface{}, len(volume.Formats))
+		for j, format := range volume.Formats {
+			formats[j] = map[string]interface{}{
+				"format": string(format.Format),
+				"url":    format.Url,
+			}
+		}
+		volumes[i] = map[string]interface{}{
+			"name":    volume.Name,
+			"formats": formats,
+		}
+	}
+	att["volumes"] = volumes
+
+	manifests := make([]interface{}, len(in.Manifests))
+	for i, manifest := range in.Manifests {
+		manifests[i] = map[string]interface{}{
+			"type": string(manifest.Type),
+			"url":  manifest.Url,
+		}
+	}
+	att["manifests"] = manifests
+
+	return []interface{}{att}
+}
+
+func FromResourceData(resourceData *schema.ResourceData) (*exportv1alpha1.VirtualMachineExport, error) {
+	result := &exportv1alpha1.VirtualMachineExport{}
+
+	result.ObjectMeta = k8s.ExpandMetadata(resourceData.Get("metadata").([]interface{}))
+	spec, err := expandVirtualMachineExportSpec(resourceData.Get("spec").([]interface{}))
+	if err != nil {
+		return result, err
+	}
+	result.Spec = spec
+
+	return result, nil
+}
+
+func ToResourceData(export exportv1alpha1.VirtualMachineExport, resourceData *schema.ResourceData) error {
+	if err := resourceData.Set("metadata", k8s.FlattenMetadata(export.ObjectMeta)); err != nil {
+		return err
+	}
+	if err := resourceData.Set("spec", flattenVirtualMachineExportSpec(export.Spec)); err != nil {
+		return err
+	}
+	if err := resourceData.Set("status", flattenVirtualMachineExportStatus(export.Status)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func AppendPatchOps(keyPrefix, pathPrefix string, resourceData *schema.ResourceData, ops []patch.PatchOperation) patch.PatchOperations {
+	return k8s.AppendPatchOps(keyPrefix+"metadata.0.", pathPrefix+"/metadata/", resourceData, ops)
+}
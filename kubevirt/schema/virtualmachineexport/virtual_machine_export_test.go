@@ -0,0 +1,116 @@
+package virtualmachineexport
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	exportv1alpha1 "kubevirt.io/api/export/v1alpha1"
+
+	"gotest.tools/assert"
+)
+
+func TestExpandFlattenVirtualMachineExportSpec(t *testing.T) {
+	secret := "export-token"
+
+	spec := exportv1alpha1.VirtualMachineExportSpec{
+		Source: k8sv1.TypedLocalObjectReference{
+			Kind: "PersistentVolumeClaim",
+			Name: "test-pvc",
+		},
+		TokenSecretRef: &secret,
+		TTLDuration:    &metav1.Duration{Duration: 2 * time.Hour},
+	}
+
+	flattened := flattenVirtualMachineExportSpec(spec)
+	assert.DeepEqual(t, flattened, []interface{}{
+		map[string]interface{}{
+			"source": []interface{}{
+				map[string]interface{}{
+					"kind": "PersistentVolumeClaim",
+					"name": "test-pvc",
+				},
+			},
+			"token_secret_ref": "export-token",
+			"ttl_duration":     "2h0m0s",
+		},
+	})
+
+	expanded, err := expandVirtualMachineExportSpec(flattened)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, expanded, spec)
+}
+
+func TestVirtualMachineExportTTLDurationRoundTrip(t *testing.T) {
+	r := &schema.Resource{Schema: VirtualMachineExportFields()}
+
+	// The export as read back, its time to live formatted by the API server.
+	resourceData := r.TestResourceData()
+	assert.NilError(t, ToResourceData(exportv1alpha1.VirtualMachineExport{
+		ObjectMeta: metav1.ObjectMeta{Name: "export", Namespace: "default"},
+		Spec: exportv1alpha1.VirtualMachineExportSpec{
+			Source:      k8sv1.TypedLocalObjectReference{Kind: "PersistentVolumeClaim", Name: "test-pvc"},
+			TTLDuration: &metav1.Duration{Duration: 2 * time.Hour},
+		},
+	}, resourceData))
+	resourceData.SetId("default/export")
+	state := resourceData.State()
+
+	config := func(ttl string) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"metadata": []interface{}{map[string]interface{}{"name": "export", "namespace": "default"}},
+			"spec": []interface{}{map[string]interface{}{
+				"source":       []interface{}{map[string]interface{}{"kind": "PersistentVolumeClaim", "name": "test-pvc"}},
+				"ttl_duration": ttl,
+			}},
+		})
+	}
+
+	diff, err := r.Diff(context.Background(), state, config("2h"), nil)
+	assert.NilError(t, err)
+	assert.Assert(t, diff == nil, "unexpected diff: %#v", diff)
+
+	diff, err = r.Diff(context.Background(), state, config("3h"), nil)
+	assert.NilError(t, err)
+	assert.Assert(t, diff.RequiresNew())
+}
+
+func TestFlattenExportLink(t *testing.T) {
+	link := exportv1alpha1.VirtualMachineExportLink{
+		Cert: "-----BEGIN CERTIFICATE-----",
+		Volumes: []exportv1alpha1.VirtualMachineExportVolume{
+			{
+				Name: "rootdisk",
+				Formats: []exportv1alpha1.VirtualMachineExportVolumeFormat{
+					{Format: exportv1alpha1.KubeVirtRaw, Url: "https://export/volumes/rootdisk/disk.img"},
+					{Format: exportv1alpha1.KubeVirtGz, Url: "https://export/volumes/rootdisk/disk.img.gz"},
+				},
+			},
+		},
+		Manifests: []exportv1alpha1.VirtualMachineExportManifest{
+			{Type: exportv1alpha1.AllManifests, Url: "https://export/internal/manifests/all"},
+		},
+	}
+
+	assert.DeepEqual(t, flattenExportLink(link), []interface{}{
+		map[string]interface{}{
+			"cert": "-----BEGIN CERTIFICATE-----",
+			"volumes": []interface{}{
+				map[string]interface{}{
+					"name": "rootdisk",
+					"formats": []interface{}{
+						map[string]interface{}{"format": "raw", "url": "https://export/volumes/rootdisk/disk.img"},
+						map[string]interface{}{"format": "gzip", "url": "https://export/volumes/rootdisk/disk.img.gz"},
+					},
+				},
+			},
+			"manifests": []interface{}{
+				map[string]interface{}{"type": "all", "url": "https://export/internal/manifests/all"},
+			},
+		},
+	})
+}
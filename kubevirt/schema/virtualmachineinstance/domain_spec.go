@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils"
+	"k8s.io/apimachinery/pkg/api/equality"
 	kubevirtapiv1 "kubevirt.io/api/core/v1"
 )
 
@@ -14,9 +15,9 @@ func domainSpecFields() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"resources": {
 			Type:        schema.TypeList,
-			Description: "Resources describes the Compute Resources required by this vmi.",
+			Description: "Resources describes the Compute Resources required by this vmi. Optional when the sizing comes from an instancetype.",
 			MaxItems:    1,
-			Required:    true,
+			Optional:    true,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"requests": {
@@ -393,6 +394,10 @@ func flattenDomainSpec(in kubevirtapiv1.DomainSpec) []interface{} {
 }
 
 func flattenResources(in kubevirtapiv1.ResourceRequirements) []interface{} {
+	if equality.Semantic.DeepEqual(in, kubevirtapiv1.ResourceRequirements{}) {
+		return nil
+	}
+
 	att := make(map[string]interface{})
 
 	att["requests"] = utils.FlattenStringMap(utils.FlattenResourceList(in.Requests))
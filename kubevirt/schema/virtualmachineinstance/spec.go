@@ -34,10 +34,13 @@ func virtualMachineInstanceSpecFields() map[string]*schema.Schema {
 		"tolerations": k8s.TolerationSchema(),
 		"eviction_strategy": {
 			Type:        schema.TypeString,
-			Description: "EvictionStrategy can be set to \"LiveMigrate\" if the VirtualMachineInstance should be migrated instead of shut-off in case of a node drain.",
+			Description: "EvictionStrategy describes the strategy to follow when a node drain occurs: \"None\", \"LiveMigrate\", \"LiveMigrateIfPossible\" or \"External\".",
 			Optional:    true,
 			ValidateFunc: validation.StringInSlice([]string{
+				"None",
 				"LiveMigrate",
+				"LiveMigrateIfPossible",
+				"External",
 			}, false),
 		},
 		"termination_grace_period_seconds": {
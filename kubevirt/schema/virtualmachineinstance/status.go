@@ -0,0 +1,276 @@
+package virtualmachineinstance
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils"
+	k8sv1 "k8s.io/api/core/v1"
+	kubevirtapiv1 "kubevirt.io/api/core/v1"
+)
+
+// VirtualMachineInstanceStatusFields are the computed runtime attributes of a VirtualMachineInstance.
+func VirtualMachineInstanceStatusFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"phase": {
+			Type:        schema.TypeString,
+			Description: "Phase is the status of the VirtualMachineInstance in kubernetes world.",
+			Computed:    true,
+		},
+		"node_name": {
+			Type:        schema.TypeString,
+			Description: "NodeName is the name where the VirtualMachineInstance is currently running.",
+			Computed:    true,
+		},
+		"live_migratable": {
+			Type:        schema.TypeBool,
+			Description: "Whether the VirtualMachineInstance can be live migrated, as reported by its LiveMigratable condition.",
+			Computed:    true,
+		},
+		"live_migratable_reason": {
+			Type:        schema.TypeString,
+			Description: "Reason of the LiveMigratable condition, such as \"DisksNotLiveMigratable\" when a volume isn't shared.",
+			Computed:    true,
+		},
+		"live_migratable_message": {
+			Type:        schema.TypeString,
+			Description: "Human readable message of the LiveMigratable condition.",
+			Computed:    true,
+		},
+		"migration_method": {
+			Type:        schema.TypeString,
+			Description: "Represents the method using which the VirtualMachineInstance can be migrated: \"BlockMigration\" or \"LiveMigration\".",
+			Computed:    true,
+		},
+		"migration_policy_name": {
+			Type:        schema.TypeString,
+			Description: "Name of the migration policy applied to the latest migration of the VirtualMachineInstance.",
+			Computed:    true,
+		},
+		"interfaces":      interfacesStatusSchema(),
+		"migration_state": MigrationStateSchema(),
+		"guest_os_info":   guestOSInfoSchema(),
+	}
+}
+
+func interfacesStatusSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Interfaces represent the details of available network interfaces.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Description: "Name of the interface, corresponds to name of the network assigned to the interface.",
+					Computed:    true,
+				},
+				"ip_address": {
+					Type:        schema.TypeString,
+					Description: "IP address of a Virtual Machine interface. It is always the first item of ip_addresses.",
+					Computed:    true,
+				},
+				"ip_addresses": {
+					Type:        schema.TypeList,
+					Description: "List of all IP addresses of a Virtual Machine interface.",
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"mac": {
+					Type:        schema.TypeString,
+					Description: "Hardware address of a Virtual Machine interface.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+// MigrationStateSchema is the computed state of a live migration.
+func MigrationStateSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Represents the status of a live migration.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"start_timestamp": {
+					Type:        schema.TypeString,
+					Description: "The time the migration action began.",
+					Computed:    true,
+				},
+				"end_timestamp": {
+					Type:        schema.TypeString,
+					Description: "The time the migration action ended.",
+					Computed:    true,
+				},
+				"source_node": {
+					Type:        schema.TypeString,
+					Description: "The source node that the VMI originated on.",
+					Computed:    true,
+				},
+				"target_node": {
+					Type:        schema.TypeString,
+					Description: "The target node that the VMI is moving to.",
+					Computed:    true,
+				},
+				"completed": {
+					Type:        schema.TypeBool,
+					Description: "Indicates the migration completed.",
+					Computed:    true,
+				},
+				"failed": {
+					Type:        schema.TypeBool,
+					Description: "Indicates that the migration failed.",
+					Computed:    true,
+				},
+				"abort_status": {
+					Type:        schema.TypeString,
+					Description: "Indicates the final status of the live migration abortion.",
+					Computed:    true,
+				},
+				"mode": {
+					Type:        schema.TypeString,
+					Description: "Lets us know if the vmi is currently running pre or post copy migration.",
+					Computed:    true,
+				},
+				"migration_policy_name": {
+					Type:        schema.TypeString,
+					Description: "Name of the migration policy applied to the migration.",
+					Computed:    true,
+				},
+				"migration_uid": {
+					Type:        schema.TypeString,
+					Description: "The VirtualMachineInstanceMigration object associated with this migration.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func guestOSInfoSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Guest OS Information, reported by the guest agent.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Description: "Name of the Guest OS.",
+					Computed:    true,
+				},
+				"id": {
+					Type:        schema.TypeString,
+					Description: "Guest OS Id.",
+					Computed:    true,
+				},
+				"pretty_name": {
+					Type:        schema.TypeString,
+					Description: "Guest OS Pretty Name.",
+					Computed:    true,
+				},
+				"version": {
+					Type:        schema.TypeString,
+					Description: "Guest OS Version.",
+					Computed:    true,
+				},
+				"version_id": {
+					Type:        schema.TypeString,
+					Description: "Version ID of the Guest OS.",
+					Computed:    true,
+				},
+				"kernel_release": {
+					Type:        schema.TypeString,
+					Description: "Guest OS Kernel Release.",
+					Computed:    true,
+				},
+				"kernel_version": {
+					Type:        schema.TypeString,
+					Description: "Kernel version of the Guest OS.",
+					Computed:    true,
+				},
+				"machine": {
+					Type:        schema.TypeString,
+					Description: "Machine type of the Guest OS.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+// FlattenVirtualMachineInstanceStatus flattens the runtime attributes of a
+// VirtualMachineInstance into the given attribute map.
+func FlattenVirtualMachineInstanceStatus(in kubevirtapiv1.VirtualMachineInstanceStatus, att map[string]interface{}) {
+	att["phase"] = string(in.Phase)
+	att["node_name"] = in.NodeName
+	att["migration_method"] = string(in.MigrationMethod)
+	for _, condition := range in.Conditions {
+		if condition.Type == kubevirtapiv1.VirtualMachineInstanceIsMigratable {
+			att["live_migratable"] = condition.Status == k8sv1.ConditionTrue
+			att["live_migratable_reason"] = condition.Reason
+			att["live_migratable_message"] = condition.Message
+		}
+	}
+	att["interfaces"] = flattenInterfacesStatus(in.Interfaces)
+	if in.MigrationState != nil {
+		att["migration_state"] = FlattenMigrationState(*in.MigrationState)
+		if in.MigrationState.MigrationPolicyName != nil {
+			att["migration_policy_name"] = *in.MigrationState.MigrationPolicyName
+		}
+	}
+	if in.GuestOSInfo != (kubevirtapiv1.VirtualMachineInstanceGuestOSInfo{}) {
+		att["guest_os_info"] = flattenGuestOSInfo(in.GuestOSInfo)
+	}
+}
+
+func flattenInterfacesStatus(in []kubevirtapiv1.VirtualMachineInstanceNetworkInterface) []interface{} {
+	att := make([]interface{}, len(in))
+
+	for i, v := range in {
+		c := make(map[string]interface{})
+
+		c["name"] = v.Name
+		c["ip_address"] = v.IP
+		c["ip_addresses"] = v.IPs
+		c["mac"] = v.MAC
+
+		att[i] = c
+	}
+
+	return att
+}
+
+func FlattenMigrationState(in kubevirtapiv1.VirtualMachineInstanceMigrationState) []interface{} {
+	att := make(map[string]interface{})
+
+	att["start_timestamp"] = utils.FlattenTime(in.StartTimestamp)
+	att["end_timestamp"] = utils.FlattenTime(in.EndTimestamp)
+	att["source_node"] = in.SourceNode
+	att["target_node"] = in.TargetNode
+	att["completed"] = in.Completed
+	att["failed"] = in.Failed
+	att["abort_status"] = string(in.AbortStatus)
+	att["mode"] = string(in.Mode)
+	att["migration_uid"] = string(in.MigrationUID)
+	if in.MigrationPolicyName != nil {
+		att["migration_policy_name"] = *in.MigrationPolicyName
+	}
+
+	return []interface{}{att}
+}
+
+func flattenGuestOSInfo(in kubevirtapiv1.VirtualMachineInstanceGuestOSInfo) []interface{} {
+	att := make(map[string]interface{})
+
+	att["name"] = in.Name
+	att["id"] = in.ID
+	att["pretty_name"] = in.PrettyName
+	att["version"] = in.Version
+	att["version_id"] = in.VersionID
+	att["kernel_release"] = in.KernelRelease
+	att["kernel_version"] = in.KernelVersion
+	att["machine"] = in.Machine
+
+	return []interface{}{att}
+}
@@ -0,0 +1,83 @@
+package virtualmachineinstance
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/k8s"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils/patch"
+	kubevirtapiv1 "kubevirt.io/api/core/v1"
+)
+
+func VirtualMachineInstanceFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"metadata": k8s.NamespacedMetadataSchema("VirtualMachineInstance", false),
+		"spec":     virtualMachineInstanceImmutableSpecSchema(),
+		"status":   virtualMachineInstanceStatusSchema(),
+	}
+}
+
+// virtualMachineInstanceImmutableSpecSchema is the spec of a standalone virtual machine
+// instance. It can't be changed once started, so any change replaces the instance;
+// KubeVirt defaults much of what is left unset, so those fields are computed and the
+// defaults read back don't replace it.
+func virtualMachineInstanceImmutableSpecSchema() *schema.Schema {
+	fields := utils.ServerDefaultedSchema(map[string]*schema.Schema{
+		"spec": virtualMachineInstanceSpecSchema(),
+	})
+	return utils.ForceNewSchema(fields)["spec"]
+}
+
+func virtualMachineInstanceStatusSchema() *schema.Schema {
+	fields := VirtualMachineInstanceStatusFields()
+
+	return &schema.Schema{
+		Type: schema.TypeList,
+
+		Description: fmt.Sprintf("VirtualMachineInstanceStatus represents information about the status of a VirtualMachineInstance."),
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+func flattenVirtualMachineInstanceStatus(in kubevirtapiv1.VirtualMachineInstanceStatus) []interface{} {
+	att := make(map[string]interface{})
+
+	FlattenVirtualMachineInstanceStatus(in, att)
+
+	return []interface{}{att}
+}
+
+func FromResourceData(resourceData *schema.ResourceData) (*kubevirtapiv1.VirtualMachineInstance, error) {
+	result := &kubevirtapiv1.VirtualMachineInstance{}
+
+	result.ObjectMeta = k8s.ExpandMetadata(resourceData.Get("metadata").([]interface{}))
+	spec, err := expandVirtualMachineInstanceSpec(resourceData.Get("spec").([]interface{}))
+	if err != nil {
+		return result, err
+	}
+	result.Spec = spec
+
+	return result, nil
+}
+
+func ToResourceData(vmi kubevirtapiv1.VirtualMachineInstance, resourceData *schema.ResourceData) error {
+	if err := resourceData.Set("metadata", k8s.FlattenMetadata(vmi.ObjectMeta)); err != nil {
+		return err
+	}
+	if err := resourceData.Set("spec", flattenVirtualMachineInstanceSpec(vmi.Spec)); err != nil {
+		return err
+	}
+	if err := resourceData.Set("status", flattenVirtualMachineInstanceStatus(vmi.Status)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func AppendPatchOps(keyPrefix, pathPrefix string, resourceData *schema.ResourceData, ops []patch.PatchOperation) patch.PatchOperations {
+	return k8s.AppendPatchOps(keyPrefix+"metadata.0.", pathPrefix+"/metadata/", resourceData, ops)
+}
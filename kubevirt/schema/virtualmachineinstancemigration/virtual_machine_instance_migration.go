@@ -0,0 +1,128 @@
+package virtualmachineinstancemigration
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/k8s"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/virtualmachineinstance"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils"
+	kubevirtapiv1 "kubevirt.io/api/core/v1"
+)
+
+func VirtualMachineInstanceMigrationFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"metadata": k8s.NamespacedMetadataSchema("VirtualMachineInstanceMigration", true),
+		"spec":     virtualMachineInstanceMigrationSpecSchema(),
+		"status":   virtualMachineInstanceMigrationStatusSchema(),
+	}
+}
+
+func virtualMachineInstanceMigrationSpecFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"vmi_name": {
+			Type:         schema.TypeString,
+			Description:  "The name of the VMI to perform the migration on. VMI must exist in the migration objects namespace.",
+			Required:     true,
+			ValidateFunc: utils.ValidateName,
+		},
+	}
+}
+
+func virtualMachineInstanceMigrationSpecSchema() *schema.Schema {
+	fields := virtualMachineInstanceMigrationSpecFields()
+
+	return &schema.Schema{
+		Type: schema.TypeList,
+
+		Description: fmt.Sprintf("VirtualMachineInstanceMigrationSpec is the specification of the live migration."),
+		Required:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+func virtualMachineInstanceMigrationStatusFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"phase": {
+			Type:        schema.TypeString,
+			Description: "Phase of the migration.",
+			Computed:    true,
+		},
+		"migration_state": virtualmachineinstance.MigrationStateSchema(),
+	}
+}
+
+func virtualMachineInstanceMigrationStatusSchema() *schema.Schema {
+	fields := virtualMachineInstanceMigrationStatusFields()
+
+	return &schema.Schema{
+		Type: schema.TypeList,
+
+		Description: fmt.Sprintf("VirtualMachineInstanceMigrationStatus represents the status of the live migration."),
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+func expandVirtualMachineInstanceMigrationSpec(virtualMachineInstanceMigrationSpec []interface{}) kubevirtapiv1.VirtualMachineInstanceMigrationSpec {
+	result := kubevirtapiv1.VirtualMachineInstanceMigrationSpec{}
+
+	if len(virtualMachineInstanceMigrationSpec) == 0 || virtualMachineInstanceMigrationSpec[0] == nil {
+		return result
+	}
+
+	in := virtualMachineInstanceMigrationSpec[0].(map[string]interface{})
+
+	if v, ok := in["vmi_name"].(string); ok {
+		result.VMIName = v
+	}
+
+	return result
+}
+
+func flattenVirtualMachineInstanceMigrationSpec(in kubevirtapiv1.VirtualMachineInstanceMigrationSpec) []interface{} {
+	att := make(map[string]interface{})
+
+	att["vmi_name"] = in.VMIName
+
+	return []interface{}{att}
+}
+
+func flattenVirtualMachineInstanceMigrationStatus(in kubevirtapiv1.VirtualMachineInstanceMigrationStatus) []interface{} {
+	att := make(map[string]interface{})
+
+	att["phase"] = string(in.Phase)
+	if in.MigrationState != nil {
+		att["migration_state"] = virtualmachineinstance.FlattenMigrationState(*in.MigrationState)
+	}
+
+	return []interface{}{att}
+}
+
+func FromResourceData(resourceData *schema.ResourceData) *kubevirtapiv1.VirtualMachineInstanceMigration {
+	result := &kubevirtapiv1.VirtualMachineInstanceMigration{}
+
+	result.ObjectMeta = k8s.ExpandMetadata(resourceData.Get("metadata").([]interface{}))
+	result.Spec = expandVirtualMachineInstanceMigrationSpec(resourceData.Get("spec").([]interface{}))
+
+	return result
+}
+
+func ToResourceData(migration kubevirtapiv1.VirtualMachineInstanceMigration, resourceData *schema.ResourceData) error {
+	if err := resourceData.Set("metadata", k8s.FlattenMetadata(migration.ObjectMeta)); err != nil {
+		return err
+	}
+	if err := resourceData.Set("spec", flattenVirtualMachineInstanceMigrationSpec(migration.Spec)); err != nil {
+		return err
+	}
+	if err := resourceData.Set("status", flattenVirtualMachineInstanceMigrationStatus(migration.Status)); err != nil {
+		return err
+	}
+
+	return nil
+}
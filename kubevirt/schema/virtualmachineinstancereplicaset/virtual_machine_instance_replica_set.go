@@ -0,0 +1,234 @@
+package virtualmachineinstancereplicaset
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/k8s"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/virtualmachineinstance"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils/patch"
+	kubevirtapiv1 "kubevirt.io/api/core/v1"
+)
+
+func VirtualMachineInstanceReplicaSetFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"metadata": k8s.NamespacedMetadataSchema("VirtualMachineInstanceReplicaSet", true),
+		"spec":     virtualMachineInstanceReplicaSetSpecSchema(),
+		"status":   virtualMachineInstanceReplicaSetStatusSchema(),
+	}
+}
+
+func virtualMachineInstanceReplicaSetSpecFields() map[string]*schema.Schema {
+	selector := k8s.LabelSelectorSchema("Label query over the virtual machine instances of the replica set, it must match the labels of the template.", false)
+	selector.Optional = false
+	selector.Required = true
+
+	template := virtualmachineinstance.VirtualMachineInstanceTemplateSpecSchema()
+	template.Optional = false
+	template.Required = true
+
+	return map[string]*schema.Schema{
+		"replicas": {
+			Type:         schema.TypeInt,
+			Description:  "Number of virtual machine instances of the replica set, it is scaled in place.",
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"selector": selector,
+		"template": template,
+		"paused": {
+			Type:        schema.TypeBool,
+			Description: "Whether the replica set controller stops reconciling the virtual machine instances of the replica set.",
+			Optional:    true,
+			Default:     false,
+		},
+	}
+}
+
+func virtualMachineInstanceReplicaSetSpecSchema() *schema.Schema {
+	fields := virtualMachineInstanceReplicaSetSpecFields()
+
+	return &schema.Schema{
+		Type: schema.TypeList,
+
+		Description: fmt.Sprintf("VirtualMachineInstanceReplicaSetSpec is the spec for a VirtualMachineInstanceReplicaSet resource."),
+		Required:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+func virtualMachineInstanceReplicaSetStatusFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"replicas": {
+			Type:        schema.TypeInt,
+			Description: "Number of non-terminated virtual machine instances of the replica set.",
+			Computed:    true,
+		},
+		"ready_replicas": {
+			Type:        schema.TypeInt,
+			Description: "Number of ready virtual machine instances of the replica set.",
+			Computed:    true,
+		},
+		"label_selector": {
+			Type:        schema.TypeString,
+			Description: "Label selector of the virtual machine instances of the replica set, in string form.",
+			Computed:    true,
+		},
+		"conditions": k8s.ConditionsSchema(),
+	}
+}
+
+func virtualMachineInstanceReplicaSetStatusSchema() *schema.Schema {
+	fields := virtualMachineInstanceReplicaSetStatusFields()
+
+	return &schema.Schema{
+		Type: schema.TypeList,
+
+		Description: fmt.Sprintf("VirtualMachineInstanceReplicaSetStatus is the status for a VirtualMachineInstanceReplicaSet resource."),
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+func expandVirtualMachineInstanceReplicaSetSpec(virtualMachineInstanceReplicaSetSpec []interface{}) (kubevirtapiv1.VirtualMachineInstanceReplicaSetSpec, error) {
+	result := kubevirtapiv1.VirtualMachineInstanceReplicaSetSpec{}
+
+	if len(virtualMachineInstanceReplicaSetSpec) == 0 || virtualMachineInstanceReplicaSetSpec[0] == nil {
+		return result, nil
+	}
+
+	in := virtualMachineInstanceReplicaSetSpec[0].(map[string]interface{})
+
+	if v, ok := in["replicas"].(int); ok {
+		replicas := int32(v)
+		result.Replicas = &replicas
+	}
+	if v, ok := in["selector"].([]interface{}); ok {
+		result.Selector = k8s.ExpandLabelSelector(v)
+	}
+	if v, ok := in["template"].([]interface{}); ok {
+		template, err := virtualmachineinstance.ExpandVirtualMachineInstanceTemplateSpec(v)
+		if err != nil {
+			return result, err
+		}
+		result.Template = template
+	}
+	if v, ok := in["paused"].(bool); ok {
+		result.Paused = v
+	}
+
+	return result, nil
+}
+
+func flattenVirtualMachineInstanceReplicaSetSpec(in kubevirtapiv1.VirtualMachineInstanceReplicaSetSpec) []interface{} {
+	att := make(map[string]interface{})
+
+	if in.Replicas != nil {
+		att["replicas"] = int(*in.Replicas)
+	}
+	if in.Selector != nil {
+		att["selector"] = k8s.FlattenLabelSelector(in.Selector)
+	}
+	if in.Template != nil {
+		att["template"] = virtualmachineinstance.FlattenVirtualMachineInstanceTemplateSpec(*in.Template)
+	}
+	att["paused"] = in.Paused
+
+	return []interface{}{att}
+}
+
+func flattenVirtualMachineInstanceReplicaSetStatus(in kubevirtapiv1.VirtualMachineInstanceReplicaSetStatus) []interface{} {
+	att := make(map[string]interface{})
+
+	att["replicas"] = int(in.Replicas)
+	att["ready_replicas"] = int(in.ReadyReplicas)
+	att["label_selector"] = in.LabelSelector
+	att["conditions"] = flattenConditions(in.Conditions)
+
+	return []interface{}{att}
+}
+
+func flattenConditions(in []kubevirtapiv1.VirtualMachineInstanceReplicaSetCondition) []interface{} {
+	att := make([]interface{}, len(in))
+
+	for i, v := range in {
+		c := make(map[string]interface{})
+
+		c["type"] = string(v.Type)
+		c["status"] = string(v.Status)
+		c["reason"] = v.Reason
+		c["message"] = v.Message
+
+		att[i] = c
+	}
+
+	return att
+}
+
+func FromResourceData(resourceData *schema.ResourceData) (*kubevirtapiv1.VirtualMachineInstanceReplicaSet, error) {
+	result := &kubevirtapiv1.VirtualMachineInstanceReplicaSet{}
+
+	result.ObjectMeta = k8s.ExpandMetadata(resourceData.Get("metadata").([]interface{}))
+	spec, err := expandVirtualMachineInstanceReplicaSetSpec(resourceData.Get("spec").([]interface{}))
+	if err != nil {
+		return result, err
+	}
+	result.Spec = spec
+
+	return result, nil
+}
+
+func ToResourceData(replicaSet kubevirtapiv1.VirtualMachineInstanceReplicaSet, resourceData *schema.ResourceData) error {
+	if err := resourceData.Set("metadata", k8s.FlattenMetadata(replicaSet.ObjectMeta)); err != nil {
+		return err
+	}
+	if err := resourceData.Set("spec", flattenVirtualMachineInstanceReplicaSetSpec(replicaSet.Spec)); err != nil {
+		return err
+	}
+	if err := resourceData.Set("status", flattenVirtualMachineInstanceReplicaSetStatus(replicaSet.Status)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AppendPatchOps patches the fields of the spec which changed, so that scaling only touches the replicas.
+func AppendPatchOps(keyPrefix, pathPrefix string, resourceData *schema.ResourceData, ops []patch.PatchOperation) (patch.PatchOperations, error) {
+	ops = k8s.AppendPatchOps(keyPrefix+"metadata.0.", pathPrefix+"/metadata/", resourceData, ops)
+
+	if !resourceData.HasChange(keyPrefix + "spec") {
+		return ops, nil
+	}
+	spec, err := expandVirtualMachineInstanceReplicaSetSpec(resourceData.Get(keyPrefix + "spec").([]interface{}))
+	if err != nil {
+		return ops, err
+	}
+
+	if resourceData.HasChange(keyPrefix + "spec.0.replicas") {
+		ops = append(ops, &patch.ReplaceOperation{
+			Path:  pathPrefix + "/spec/replicas",
+			Value: *spec.Replicas,
+		})
+	}
+	if resourceData.HasChange(keyPrefix + "spec.0.paused") {
+		ops = append(ops, &patch.AddOperation{
+			Path:  pathPrefix + "/spec/paused",
+			Value: spec.Paused,
+		})
+	}
+	if resourceData.HasChange(keyPrefix + "spec.0.template") {
+		ops = append(ops, &patch.ReplaceOperation{
+			Path:  pathPrefix + "/spec/template",
+			Value: spec.Template,
+		})
+	}
+
+	return ops, nil
+}
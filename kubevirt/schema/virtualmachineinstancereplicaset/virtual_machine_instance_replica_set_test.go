@@ -0,0 +1,39 @@
+package virtualmachineinstancereplicaset
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubevirtapiv1 "kubevirt.io/api/core/v1"
+
+	"gotest.tools/assert"
+)
+
+func TestExpandFlattenVirtualMachineInstanceReplicaSetSpec(t *testing.T) {
+	replicas := int32(2)
+
+	spec := kubevirtapiv1.VirtualMachineInstanceReplicaSetSpec{
+		Replicas: &replicas,
+		Selector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{"app": "ephemeral"},
+		},
+		Paused: false,
+	}
+
+	flattened := flattenVirtualMachineInstanceReplicaSetSpec(spec)
+	assert.DeepEqual(t, flattened, []interface{}{
+		map[string]interface{}{
+			"replicas": 2,
+			"selector": []interface{}{
+				map[string]interface{}{
+					"match_labels": map[string]interface{}{"app": "ephemeral"},
+				},
+			},
+			"paused": false,
+		},
+	})
+
+	expanded, err := expandVirtualMachineInstanceReplicaSetSpec(flattened)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, expanded, spec)
+}
@@ -0,0 +1,266 @@
+package virtualmachinepool
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/k8s"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/virtualmachine"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils/patch"
+	poolv1alpha1 "kubevirt.io/api/pool/v1alpha1"
+)
+
+func VirtualMachinePoolFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"metadata": k8s.NamespacedMetadataSchema("VirtualMachinePool", true),
+		"spec":     virtualMachinePoolSpecSchema(),
+		"status":   virtualMachinePoolStatusSchema(),
+	}
+}
+
+func virtualMachinePoolSpecFields() map[string]*schema.Schema {
+	selector := k8s.LabelSelectorSchema("Label query over the virtual machines of the pool, it must match the labels of the template.", false)
+	selector.Optional = false
+	selector.Required = true
+
+	return map[string]*schema.Schema{
+		"replicas": {
+			Type:         schema.TypeInt,
+			Description:  "Number of virtual machines of the pool, it is scaled in place.",
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"selector":                 selector,
+		"virtual_machine_template": virtualMachineTemplateSpecSchema(),
+		"paused": {
+			Type:        schema.TypeBool,
+			Description: "Whether the pool controller stops reconciling the virtual machines of the pool.",
+			Optional:    true,
+			Default:     false,
+		},
+	}
+}
+
+func virtualMachinePoolSpecSchema() *schema.Schema {
+	fields := virtualMachinePoolSpecFields()
+
+	return &schema.Schema{
+		Type: schema.TypeList,
+
+		Description: fmt.Sprintf("VirtualMachinePoolSpec is the spec for a VirtualMachinePool resource."),
+		Required:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+func virtualMachineTemplateSpecFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"metadata": k8s.NamespacedMetadataSchema("VirtualMachineTemplateSpec", false),
+		"spec":     virtualmachine.VirtualMachineSpecSchema(),
+	}
+}
+
+func virtualMachineTemplateSpecSchema() *schema.Schema {
+	fields := virtualMachineTemplateSpecFields()
+
+	return &schema.Schema{
+		Type: schema.TypeList,
+
+		Description: fmt.Sprintf("Template of the virtual machines of the pool."),
+		Required:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+func virtualMachinePoolStatusFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"replicas": {
+			Type:        schema.TypeInt,
+			Description: "Number of virtual machines of the pool.",
+			Computed:    true,
+		},
+		"ready_replicas": {
+			Type:        schema.TypeInt,
+			Description: "Number of ready virtual machines of the pool.",
+			Computed:    true,
+		},
+		"label_selector": {
+			Type:        schema.TypeString,
+			Description: "Label selector of the virtual machines of the pool, in string form.",
+			Computed:    true,
+		},
+		"conditions": k8s.ConditionsSchema(),
+	}
+}
+
+func virtualMachinePoolStatusSchema() *schema.Schema {
+	fields := virtualMachinePoolStatusFields()
+
+	return &schema.Schema{
+		Type: schema.TypeList,
+
+		Description: fmt.Sprintf("VirtualMachinePoolStatus is the status for a VirtualMachinePool resource."),
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+func expandVirtualMachinePoolSpec(virtualMachinePoolSpec []interface{}) (poolv1alpha1.VirtualMachinePoolSpec, error) {
+	result := poolv1alpha1.VirtualMachinePoolSpec{}
+
+	if len(virtualMachinePoolSpec) == 0 || virtualMachinePoolSpec[0] == nil {
+		return result, nil
+	}
+
+	in := virtualMachinePoolSpec[0].(map[string]interface{})
+
+	if v, ok := in["replicas"].(int); ok {
+		replicas := int32(v)
+		result.Replicas = &replicas
+	}
+	if v, ok := in["selector"].([]interface{}); ok {
+		result.Selector = k8s.ExpandLabelSelector(v)
+	}
+	if v, ok := in["virtual_machine_template"].([]interface{}); ok {
+		template, err := expandVirtualMachineTemplateSpec(v)
+		if err != nil {
+			return result, err
+		}
+		result.VirtualMachineTemplate = template
+	}
+	if v, ok := in["paused"].(bool); ok {
+		result.Paused = v
+	}
+
+	return result, nil
+}
+
+func expandVirtualMachineTemplateSpec(virtualMachineTemplateSpec []interface{}) (*poolv1alpha1.VirtualMachineTemplateSpec, error) {
+	if len(virtualMachineTemplateSpec) == 0 || virtualMachineTemplateSpec[0] == nil {
+		return nil, nil
+	}
+
+	result := &poolv1alpha1.VirtualMachineTemplateSpec{}
+	in := virtualMachineTemplateSpec[0].(map[string]interface{})
+
+	if v, ok := in["metadata"].([]interface{}); ok {
+		result.ObjectMeta = k8s.ExpandMetadata(v)
+	}
+	if v, ok := in["spec"].([]interface{}); ok {
+		spec, err := virtualmachine.ExpandVirtualMachineSpec(v)
+		if err != nil {
+			return result, err
+		}
+		result.Spec = spec
+	}
+
+	return result, nil
+}
+
+func flattenVirtualMachinePoolSpec(in poolv1alpha1.VirtualMachinePoolSpec) []interface{} {
+	att := make(map[string]interface{})
+
+	if in.Replicas != nil {
+		att["replicas"] = int(*in.Replicas)
+	}
+	if in.Selector != nil {
+		att["selector"] = k8s.FlattenLabelSelector(in.Selector)
+	}
+	if in.VirtualMachineTemplate != nil {
+		att["virtual_machine_template"] = flattenVirtualMachineTemplateSpec(*in.VirtualMachineTemplate)
+	}
+	att["paused"] = in.Paused
+
+	return []interface{}{att}
+}
+
+func flattenVirtualMachineTemplateSpec(in poolv1alpha1.VirtualMachineTemplateSpec) []interface{} {
+	att := make(map[string]interface{})
+
+	att["metadata"] = k8s.FlattenMetadata(in.ObjectMeta)
+	att["spec"] = virtualmachine.FlattenVirtualMachineSpec(in.Spec)
+
+	return []interface{}{att}
+}
+
+func flattenVirtualMachinePoolStatus(in poolv1alpha1.VirtualMachinePoolStatus) []interface{} {
+	att := make(map[string]interface{})
+
+	att["replicas"] = int(in.Replicas)
+	att["ready_replicas"] = int(in.ReadyReplicas)
+	att["label_selector"] = in.LabelSelector
+	att["conditions"] = flattenConditions(in.Conditions)
+
+	return []interface{}{att}
+}
+
+func flattenConditions(in []poolv1alpha1.VirtualMachinePoolCondition) []interface{} {
+	att := make([]interface{}, len(in))
+
+	for i, v := range in {
+		c := make(map[string]interface{})
+
+		c["type"] = string(v.Type)
+		c["status"] = string(v.Status)
+		c["reason"] = v.Reason
+		c["message"] = v.Message
+
+		att[i] = c
+	}
+
+	return att
+}
+
+func FromResourceData(resourceData *schema.ResourceData) (*poolv1alpha1.VirtualMachinePool, error) {
+	result := &poolv1alpha1.VirtualMachinePool{}
+
+	result.ObjectMeta = k8s.ExpandMetadata(resourceData.Get("metadata").([]interface{}))
+	spec, err := expandVirtualMachinePoolSpec(resourceData.Get("spec").([]interface{}))
+	if err != nil {
+		return result, err
+	}
+	result.Spec = spec
+
+	return result, nil
+}
+
+func ToResourceData(pool poolv1alpha1.VirtualMachinePool, resourceData *schema.ResourceData) error {
+	if err := resourceData.Set("metadata", k8s.FlattenMetadata(pool.ObjectMeta)); err != nil {
+		return err
+	}
+	if err := resourceData.Set("spec", flattenVirtualMachinePoolSpec(pool.Spec)); err != nil {
+		return err
+	}
+	if err := resourceData.Set("status", flattenVirtualMachinePoolStatus(pool.Status)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func AppendPatchOps(keyPrefix, pathPrefix string, resourceData *schema.ResourceData, ops []patch.PatchOperation) (patch.PatchOperations, error) {
+	ops = k8s.AppendPatchOps(keyPrefix+"metadata.0.", pathPrefix+"/metadata/", resourceData, ops)
+
+	if resourceData.HasChange(keyPrefix + "spec") {
+		spec, err := expandVirtualMachinePoolSpec(resourceData.Get(keyPrefix + "spec").([]interface{}))
+		if err != nil {
+			return ops, err
+		}
+		ops = append(ops, &patch.ReplaceOperation{
+			Path:  pathPrefix + "/spec",
+			Value: spec,
+		})
+	}
+
+	return ops, nil
+}
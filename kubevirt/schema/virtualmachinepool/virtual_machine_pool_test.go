@@ -0,0 +1,47 @@
+package virtualmachinepool
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubevirtapiv1 "kubevirt.io/api/core/v1"
+	poolv1alpha1 "kubevirt.io/api/pool/v1alpha1"
+
+	"gotest.tools/assert"
+)
+
+func TestExpandFlattenVirtualMachinePoolSpec(t *testing.T) {
+	replicas := int32(3)
+	runStrategy := kubevirtapiv1.RunStrategyAlways
+
+	spec := poolv1alpha1.VirtualMachinePoolSpec{
+		Replicas: &replicas,
+		Selector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{"pool": "build-agents"},
+		},
+		VirtualMachineTemplate: &poolv1alpha1.VirtualMachineTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{"pool": "build-agents"},
+			},
+			Spec: kubevirtapiv1.VirtualMachineSpec{
+				RunStrategy:         &runStrategy,
+				DataVolumeTemplates: []kubevirtapiv1.DataVolumeTemplateSpec{},
+			},
+		},
+		Paused: true,
+	}
+
+	flattened := flattenVirtualMachinePoolSpec(spec)
+	in := flattened[0].(map[string]interface{})
+	assert.Equal(t, in["replicas"], 3)
+	assert.Equal(t, in["paused"], true)
+	assert.DeepEqual(t, in["selector"], []interface{}{
+		map[string]interface{}{
+			"match_labels": map[string]interface{}{"pool": "build-agents"},
+		},
+	})
+
+	expanded, err := expandVirtualMachinePoolSpec(flattened)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, expanded, spec)
+}
@@ -0,0 +1,230 @@
+package virtualmachinerestore
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/k8s"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/virtualmachinesnapshot"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils"
+	snapshotv1alpha1 "kubevirt.io/api/snapshot/v1alpha1"
+)
+
+// VirtualMachineRestoreFields are the fields of a restore. A restore runs once, so a change of its
+// metadata or spec requests another one, while the power fields only matter while it runs.
+func VirtualMachineRestoreFields() map[string]*schema.Schema {
+	fields := utils.ForceNewSchema(map[string]*schema.Schema{
+		"metadata": k8s.NamespacedMetadataSchema("VirtualMachineRestore", true),
+		"spec":     virtualMachineRestoreSpecSchema(),
+	})
+	fields["status"] = virtualMachineRestoreStatusSchema()
+	fields["stop_before_restore"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Stop the target virtual machine through its stop subresource before restoring it, KubeVirt only restores stopped virtual machines.",
+		Optional:    true,
+		Default:     false,
+	}
+	fields["start_after_restore"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Start the target virtual machine through its start subresource once the restore is complete.",
+		Optional:    true,
+		Default:     false,
+	}
+
+	return fields
+}
+
+func virtualMachineRestoreSpecFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"target": k8s.TypedLocalObjectReferenceSchema("Target is the virtual machine to restore.", true),
+		"virtual_machine_snapshot_name": {
+			Type:         schema.TypeString,
+			Description:  "Name of the VirtualMachineSnapshot to restore, in the namespace of the restore.",
+			Required:     true,
+			ValidateFunc: utils.ValidateName,
+		},
+		"patches": {
+			Type:        schema.TypeList,
+			Description: "JSON patches applied to the target manifest when the restore creates it, because it doesn't exist.",
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+func virtualMachineRestoreSpecSchema() *schema.Schema {
+	fields := virtualMachineRestoreSpecFields()
+
+	return &schema.Schema{
+		Type: schema.TypeList,
+
+		Description: fmt.Sprintf("VirtualMachineRestoreSpec is the spec for a VirtualMachineRestore resource."),
+		Required:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+func virtualMachineRestoreStatusFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"complete": {
+			Type:        schema.TypeBool,
+			Description: "Whether the restore is complete.",
+			Computed:    true,
+		},
+		"restore_time": {
+			Type:        schema.TypeString,
+			Description: "Time the restore completed.",
+			Computed:    true,
+		},
+		"conditions": k8s.ConditionsSchema(),
+		"restores": {
+			Type:        schema.TypeList,
+			Description: "Volumes restored from their snapshot.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"volume_name": {
+						Type:        schema.TypeString,
+						Description: "Name of the volume of the virtual machine.",
+						Computed:    true,
+					},
+					"persistent_volume_claim_name": {
+						Type:        schema.TypeString,
+						Description: "Name of the PersistentVolumeClaim restored.",
+						Computed:    true,
+					},
+					"volume_snapshot_name": {
+						Type:        schema.TypeString,
+						Description: "Name of the VolumeSnapshot the volume is restored from.",
+						Computed:    true,
+					},
+					"data_volume_name": {
+						Type:        schema.TypeString,
+						Description: "Name of the DataVolume restored, if any.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"deleted_data_volumes": {
+			Type:        schema.TypeList,
+			Description: "DataVolumes deleted because the restore replaced them.",
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+func virtualMachineRestoreStatusSchema() *schema.Schema {
+	fields := virtualMachineRestoreStatusFields()
+
+	return &schema.Schema{
+		Type: schema.TypeList,
+
+		Description: fmt.Sprintf("VirtualMachineRestoreStatus is the status for a VirtualMachineRestore resource."),
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+func expandVirtualMachineRestoreSpec(virtualMachineRestoreSpec []interface{}) snapshotv1alpha1.VirtualMachineRestoreSpec {
+	result := snapshotv1alpha1.VirtualMachineRestoreSpec{}
+
+	if len(virtualMachineRestoreSpec) == 0 || virtualMachineRestoreSpec[0] == nil {
+		return result
+	}
+
+	in := virtualMachineRestoreSpec[0].(map[string]interface{})
+
+	if v, ok := in["target"].([]interface{}); ok {
+		result.Target = k8s.ExpandTypedLocalObjectReference(v)
+	}
+	if v, ok := in["virtual_machine_snapshot_name"].(string); ok {
+		result.VirtualMachineSnapshotName = v
+	}
+	if v, ok := in["patches"].([]interface{}); ok && len(v) > 0 {
+		result.Patches = make([]string, len(v))
+		for i, patch := range v {
+			result.Patches[i] = patch.(string)
+		}
+	}
+
+	return result
+}
+
+func flattenVirtualMachineRestoreSpec(in snapshotv1alpha1.VirtualMachineRestoreSpec) []interface{} {
+	att := make(map[string]interface{})
+
+	att["target"] = k8s.FlattenTypedLocalObjectReference(in.Target)
+	att["virtual_machine_snapshot_name"] = in.VirtualMachineSnapshotName
+	patches := make([]interface{}, len(in.Patches))
+	for i, patch := range in.Patches {
+		patches[i] = patch
+	}
+	att["patches"] = patches
+
+	return []interface{}{att}
+}
+
+func flattenVirtualMachineRestoreStatus(in *snapshotv1alpha1.VirtualMachineRestoreStatus) []interface{} {
+	att := make(map[string]interface{})
+
+	if in != nil {
+		if in.Complete != nil {
+			att["complete"] = *in.Complete
+		}
+		att["restore_time"] = utils.FlattenTime(in.RestoreTime)
+		att["conditions"] = virtualmachinesnapshot.FlattenConditions(in.Conditions)
+		att["restores"] = flattenVolumeRestores(in.Restores)
+		att["deleted_data_volumes"] = in.DeletedDataVolumes
+	}
+
+	return []interface{}{att}
+}
+
+func flattenVolumeRestores(in []snapshotv1alpha1.VolumeRestore) []interface{} {
+	att := make([]interface{}, len(in))
+
+	for i, v := range in {
+		c := make(map[string]interface{})
+
+		c["volume_name"] = v.VolumeName
+		c["persistent_volume_claim_name"] = v.PersistentVolumeClaimName
+		c["volume_snapshot_name"] = v.VolumeSnapshotName
+		if v.DataVolumeName != nil {
+			c["data_volume_name"] = *v.DataVolumeName
+		}
+
+		att[i] = c
+	}
+
+	return att
+}
+
+func FromResourceData(resourceData *schema.ResourceData) *snapshotv1alpha1.VirtualMachineRestore {
+	result := &snapshotv1alpha1.VirtualMachineRestore{}
+
+	result.ObjectMeta = k8s.ExpandMetadata(resourceData.Get("metadata").([]interface{}))
+	result.Spec = expandVirtualMachineRestoreSpec(resourceData.Get("spec").([]interface{}))
+
+	return result
+}
+
+func ToResourceData(restore snapshotv1alpha1.VirtualMachineRestore, resourceData *schema.ResourceData) error {
+	if err := resourceData.Set("metadata", k8s.FlattenMetadata(restore.ObjectMeta)); err != nil {
+		return err
+	}
+	if err := resourceData.Set("spec", flattenVirtualMachineRestoreSpec(restore.Spec)); err != nil {
+		return err
+	}
+	if err := resourceData.Set("status", flattenVirtualMachineRestoreStatus(restore.Status)); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package virtualmachinerestore
+
+import (
+	"testing"
+
+	k8sv1 "k8s.io/api/core/v1"
+	snapshotv1alpha1 "kubevirt.io/api/snapshot/v1alpha1"
+
+	"gotest.tools/assert"
+)
+
+func TestExpandFlattenVirtualMachineRestoreSpec(t *testing.T) {
+	apiGroup := "kubevirt.io"
+
+	spec := snapshotv1alpha1.VirtualMachineRestoreSpec{
+		Target: k8sv1.TypedLocalObjectReference{
+			APIGroup: &apiGroup,
+			Kind:     "VirtualMachine",
+			Name:     "test-vm",
+		},
+		VirtualMachineSnapshotName: "test-vm-snapshot",
+		Patches:                    []string{`{"op": "replace", "path": "/metadata/name", "value": "test-vm-restored"}`},
+	}
+
+	flattened := flattenVirtualMachineRestoreSpec(spec)
+	assert.DeepEqual(t, flattened, []interface{}{
+		map[string]interface{}{
+			"target": []interface{}{
+				map[string]interface{}{
+					"api_group": "kubevirt.io",
+					"kind":      "VirtualMachine",
+					"name":      "test-vm",
+				},
+			},
+			"virtual_machine_snapshot_name": "test-vm-snapshot",
+			"patches": []interface{}{
+				`{"op": "replace", "path": "/metadata/name", "value": "test-vm-restored"}`,
+			},
+		},
+	})
+
+	assert.DeepEqual(t, expandVirtualMachineRestoreSpec(flattened), spec)
+}
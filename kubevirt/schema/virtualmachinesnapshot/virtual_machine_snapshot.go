@@ -0,0 +1,302 @@
+package virtualmachinesnapshot
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/schema/k8s"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils"
+	"github.com/kubevirt/terraform-provider-kubevirt/kubevirt/utils/patch"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	snapshotv1alpha1 "kubevirt.io/api/snapshot/v1alpha1"
+)
+
+func VirtualMachineSnapshotFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"metadata": k8s.NamespacedMetadataSchema("VirtualMachineSnapshot", true),
+		"spec":     virtualMachineSnapshotSpecSchema(),
+		"status":   virtualMachineSnapshotStatusSchema(),
+	}
+}
+
+func virtualMachineSnapshotSpecFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"source": k8s.TypedLocalObjectReferenceSchema("Source is the object the snapshot is taken of.", true),
+		"deletion_policy": {
+			Type:        schema.TypeString,
+			Description: "DeletionPolicy defines whether the snapshot content is deleted along with the snapshot: \"Delete\" or \"Retain\".",
+			Optional:    true,
+			ForceNew:    true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(snapshotv1alpha1.VirtualMachineSnapshotContentDelete),
+				string(snapshotv1alpha1.VirtualMachineSnapshotContentRetain),
+			}, false),
+		},
+		"failure_deadline": {
+			Type:             schema.TypeString,
+			Description:      "FailureDeadline is the duration, such as \"5m\", after which the snapshot fails if it isn't complete yet. \"0s\" disables the deadline.",
+			Optional:         true,
+			ForceNew:         true,
+			ValidateFunc:     utils.ValidateDuration,
+			DiffSuppressFunc: utils.SuppressEquivalentDuration,
+		},
+	}
+}
+
+func virtualMachineSnapshotSpecSchema() *schema.Schema {
+	fields := virtualMachineSnapshotSpecFields()
+
+	return &schema.Schema{
+		Type: schema.TypeList,
+
+		Description: fmt.Sprintf("VirtualMachineSnapshotSpec is the spec for a VirtualMachineSnapshot resource."),
+		Required:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+func virtualMachineSnapshotStatusFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"phase": {
+			Type:        schema.TypeString,
+			Description: "Phase of the snapshot.",
+			Computed:    true,
+		},
+		"ready_to_use": {
+			Type:        schema.TypeBool,
+			Description: "Whether the snapshot can be restored.",
+			Computed:    true,
+		},
+		"virtual_machine_snapshot_content_name": {
+			Type:        schema.TypeString,
+			Description: "Name of the VirtualMachineSnapshotContent holding the snapshot.",
+			Computed:    true,
+		},
+		"creation_time": {
+			Type:        schema.TypeString,
+			Description: "Time the snapshot was taken.",
+			Computed:    true,
+		},
+		"indications": {
+			Type:        schema.TypeList,
+			Description: "Indications about how the snapshot was taken: \"Online\", \"GuestAgent\" or \"NoGuestAgent\".",
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"error": {
+			Type:        schema.TypeString,
+			Description: "Last error encountered while taking the snapshot.",
+			Computed:    true,
+		},
+		"conditions": k8s.ConditionsSchema(),
+		"included_volumes": {
+			Type:        schema.TypeList,
+			Description: "Volumes included in the snapshot.",
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"excluded_volumes": {
+			Type:        schema.TypeList,
+			Description: "Volumes excluded from the snapshot, because they can't be snapshotted.",
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"volume_snapshot_statuses": {
+			Type:        schema.TypeList,
+			Description: "Status of the snapshot of each volume, as reported by the snapshot content.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"volume_snapshot_name": {
+						Type:        schema.TypeString,
+						Description: "Name of the VolumeSnapshot.",
+						Computed:    true,
+					},
+					"creation_time": {
+						Type:        schema.TypeString,
+						Description: "Time the volume was snapshotted.",
+						Computed:    true,
+					},
+					"ready_to_use": {
+						Type:        schema.TypeBool,
+						Description: "Whether the volume snapshot can be restored.",
+						Computed:    true,
+					},
+					"error": {
+						Type:        schema.TypeString,
+						Description: "Last error encountered while snapshotting the volume.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func virtualMachineSnapshotStatusSchema() *schema.Schema {
+	fields := virtualMachineSnapshotStatusFields()
+
+	return &schema.Schema{
+		Type: schema.TypeList,
+
+		Description: fmt.Sprintf("VirtualMachineSnapshotStatus is the status for a VirtualMachineSnapshot resource."),
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+func expandVirtualMachineSnapshotSpec(virtualMachineSnapshotSpec []interface{}) (snapshotv1alpha1.VirtualMachineSnapshotSpec, error) {
+	result := snapshotv1alpha1.VirtualMachineSnapshotSpec{}
+
+	if len(virtualMachineSnapshotSpec) == 0 || virtualMachineSnapshotSpec[0] == nil {
+		return result, nil
+	}
+
+	in := virtualMachineSnapshotSpec[0].(map[string]interface{})
+
+	if v, ok := in["source"].([]interface{}); ok {
+		result.Source = k8s.ExpandTypedLocalObjectReference(v)
+	}
+	if v, ok := in["deletion_policy"].(string); ok && v != "" {
+		policy := snapshotv1alpha1.DeletionPolicy(v)
+		result.DeletionPolicy = &policy
+	}
+	if v, ok := in["failure_deadline"].(string); ok && v != "" {
+		deadline, err := time.ParseDuration(v)
+		if err != nil {
+			return result, err
+		}
+		result.FailureDeadline = &metav1.Duration{Duration: deadline}
+	}
+
+	return result, nil
+}
+
+func flattenVirtualMachineSnapshotSpec(in snapshotv1alpha1.VirtualMachineSnapshotSpec) []interface{} {
+	att := make(map[string]interface{})
+
+	att["source"] = k8s.FlattenTypedLocalObjectReference(in.Source)
+	if in.DeletionPolicy != nil {
+		att["deletion_policy"] = string(*in.DeletionPolicy)
+	}
+	if in.FailureDeadline != nil {
+		att["failure_deadline"] = in.FailureDeadline.Duration.String()
+	}
+
+	return []interface{}{att}
+}
+
+func flattenVirtualMachineSnapshotStatus(in *snapshotv1alpha1.VirtualMachineSnapshotStatus, content *snapshotv1alpha1.VirtualMachineSnapshotContent) []interface{} {
+	att := make(map[string]interface{})
+
+	if in != nil {
+		att["phase"] = string(in.Phase)
+		if in.ReadyToUse != nil {
+			att["ready_to_use"] = *in.ReadyToUse
+		}
+		if in.VirtualMachineSnapshotContentName != nil {
+			att["virtual_machine_snapshot_content_name"] = *in.VirtualMachineSnapshotContentName
+		}
+		att["creation_time"] = utils.FlattenTime(in.CreationTime)
+		indications := make([]string, len(in.Indications))
+		for i, indication := range in.Indications {
+			indications[i] = string(indication)
+		}
+		att["indications"] = indications
+		att["error"] = flattenError(in.Error)
+		att["conditions"] = FlattenConditions(in.Conditions)
+		if in.SnapshotVolumes != nil {
+			att["included_volumes"] = in.SnapshotVolumes.IncludedVolumes
+			att["excluded_volumes"] = in.SnapshotVolumes.ExcludedVolumes
+		}
+	}
+	if content != nil && content.Status != nil {
+		att["volume_snapshot_statuses"] = flattenVolumeSnapshotStatuses(content.Status.VolumeSnapshotStatus)
+	}
+
+	return []interface{}{att}
+}
+
+// FlattenConditions flattens the conditions of the snapshot API objects.
+func FlattenConditions(in []snapshotv1alpha1.Condition) []interface{} {
+	att := make([]interface{}, len(in))
+
+	for i, v := range in {
+		c := make(map[string]interface{})
+
+		c["type"] = string(v.Type)
+		c["status"] = string(v.Status)
+		c["reason"] = v.Reason
+		c["message"] = v.Message
+
+		att[i] = c
+	}
+
+	return att
+}
+
+func flattenVolumeSnapshotStatuses(in []snapshotv1alpha1.VolumeSnapshotStatus) []interface{} {
+	att := make([]interface{}, len(in))
+
+	for i, v := range in {
+		c := make(map[string]interface{})
+
+		c["volume_snapshot_name"] = v.VolumeSnapshotName
+		c["creation_time"] = utils.FlattenTime(v.CreationTime)
+		if v.ReadyToUse != nil {
+			c["ready_to_use"] = *v.ReadyToUse
+		}
+		c["error"] = flattenError(v.Error)
+
+		att[i] = c
+	}
+
+	return att
+}
+
+func flattenError(in *snapshotv1alpha1.Error) string {
+	if in == nil || in.Message == nil {
+		return ""
+	}
+	return *in.Message
+}
+
+func FromResourceData(resourceData *schema.ResourceData) (*snapshotv1alpha1.VirtualMachineSnapshot, error) {
+	result := &snapshotv1alpha1.VirtualMachineSnapshot{}
+
+	result.ObjectMeta = k8s.ExpandMetadata(resourceData.Get("metadata").([]interface{}))
+	spec, err := expandVirtualMachineSnapshotSpec(resourceData.Get("spec").([]interface{}))
+	if err != nil {
+		return result, err
+	}
+	result.Spec = spec
+
+	return result, nil
+}
+
+// ToResourceData sets the snapshot along with the status of its volumes, found in its content if any.
+func ToResourceData(snapshot snapshotv1alpha1.VirtualMachineSnapshot, content *snapshotv1alpha1.VirtualMachineSnapshotContent, resourceData *schema.ResourceData) error {
+	if err := resourceData.Set("metadata", k8s.FlattenMetadata(snapshot.ObjectMeta)); err != nil {
+		return err
+	}
+	if err := resourceData.Set("spec", flattenVirtualMachineSnapshotSpec(snapshot.Spec)); err != nil {
+		return err
+	}
+	if err := resourceData.Set("status", flattenVirtualMachineSnapshotStatus(snapshot.Status, content)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func AppendPatchOps(keyPrefix, pathPrefix string, resourceData *schema.ResourceData, ops []patch.PatchOperation) patch.PatchOperations {
+	return k8s.AppendPatchOps(keyPrefix+"metadata.0.", pathPrefix+"/metadata/", resourceData, ops)
+}
@@ -0,0 +1,145 @@
+package virtualmachinesnapshot
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	snapshotv1alpha1 "kubevirt.io/api/snapshot/v1alpha1"
+
+	"gotest.tools/assert"
+)
+
+func TestExpandFlattenVirtualMachineSnapshotSpec(t *testing.T) {
+	apiGroup := "kubevirt.io"
+	policy := snapshotv1alpha1.VirtualMachineSnapshotContentRetain
+
+	spec := snapshotv1alpha1.VirtualMachineSnapshotSpec{
+		Source: k8sv1.TypedLocalObjectReference{
+			APIGroup: &apiGroup,
+			Kind:     "VirtualMachine",
+			Name:     "test-vm",
+		},
+		DeletionPolicy:  &policy,
+		FailureDeadline: &metav1.Duration{Duration: 5 * time.Minute},
+	}
+
+	flattened := flattenVirtualMachineSnapshotSpec(spec)
+	assert.DeepEqual(t, flattened, []interface{}{
+		map[string]interface{}{
+			"source": []interface{}{
+				map[string]interface{}{
+					"api_group": "kubevirt.io",
+					"kind":      "VirtualMachine",
+					"name":      "test-vm",
+				},
+			},
+			"deletion_policy":  "Retain",
+			"failure_deadline": "5m0s",
+		},
+	})
+
+	expanded, err := expandVirtualMachineSnapshotSpec(flattened)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, expanded, spec)
+}
+
+func TestVirtualMachineSnapshotFailureDeadlineRoundTrip(t *testing.T) {
+	apiGroup := "kubevirt.io"
+	r := &schema.Resource{Schema: VirtualMachineSnapshotFields()}
+
+	// The snapshot as read back, its deadline formatted by the API server.
+	resourceData := r.TestResourceData()
+	assert.NilError(t, ToResourceData(snapshotv1alpha1.VirtualMachineSnapshot{
+		ObjectMeta: metav1.ObjectMeta{Name: "snapshot", Namespace: "default"},
+		Spec: snapshotv1alpha1.VirtualMachineSnapshotSpec{
+			Source:          k8sv1.TypedLocalObjectReference{APIGroup: &apiGroup, Kind: "VirtualMachine", Name: "test-vm"},
+			FailureDeadline: &metav1.Duration{Duration: 5 * time.Minute},
+		},
+	}, nil, resourceData))
+	resourceData.SetId("default/snapshot")
+	state := resourceData.State()
+
+	config := func(deadline string) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"metadata": []interface{}{map[string]interface{}{"name": "snapshot", "namespace": "default"}},
+			"spec": []interface{}{map[string]interface{}{
+				"source":           []interface{}{map[string]interface{}{"kind": "VirtualMachine", "name": "test-vm"}},
+				"failure_deadline": deadline,
+			}},
+		})
+	}
+
+	diff, err := r.Diff(context.Background(), state, config("5m"), nil)
+	assert.NilError(t, err)
+	assert.Assert(t, !diff.RequiresNew())
+	assert.Assert(t, diff == nil || diff.Attributes["spec.0.failure_deadline"] == nil)
+
+	diff, err = r.Diff(context.Background(), state, config("10m"), nil)
+	assert.NilError(t, err)
+	assert.Assert(t, diff.RequiresNew())
+}
+
+func TestFlattenVirtualMachineSnapshotStatus(t *testing.T) {
+	ready := true
+	contentName := "vmsnapshot-content-1"
+	creationTime := metav1.NewTime(time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC))
+
+	status := &snapshotv1alpha1.VirtualMachineSnapshotStatus{
+		Phase:                             snapshotv1alpha1.Succeeded,
+		ReadyToUse:                        &ready,
+		VirtualMachineSnapshotContentName: &contentName,
+		CreationTime:                      &creationTime,
+		Indications: []snapshotv1alpha1.Indication{
+			snapshotv1alpha1.VMSnapshotOnlineSnapshotIndication,
+			snapshotv1alpha1.VMSnapshotGuestAgentIndication,
+		},
+		Conditions: []snapshotv1alpha1.Condition{
+			{Type: snapshotv1alpha1.ConditionReady, Status: k8sv1.ConditionTrue, Reason: "Operation complete"},
+		},
+		SnapshotVolumes: &snapshotv1alpha1.SnapshotVolumesLists{
+			IncludedVolumes: []string{"rootdisk"},
+			ExcludedVolumes: []string{"cloudinitdisk"},
+		},
+	}
+	content := &snapshotv1alpha1.VirtualMachineSnapshotContent{
+		Status: &snapshotv1alpha1.VirtualMachineSnapshotContentStatus{
+			VolumeSnapshotStatus: []snapshotv1alpha1.VolumeSnapshotStatus{
+				{VolumeSnapshotName: "vmsnapshot-rootdisk", CreationTime: &creationTime, ReadyToUse: &ready},
+			},
+		},
+	}
+
+	assert.DeepEqual(t, flattenVirtualMachineSnapshotStatus(status, content), []interface{}{
+		map[string]interface{}{
+			"phase":                                 "Succeeded",
+			"ready_to_use":                          true,
+			"virtual_machine_snapshot_content_name": "vmsnapshot-content-1",
+			"creation_time":                         "2023-03-01T12:00:00Z",
+			"indications":                           []string{"Online", "GuestAgent"},
+			"error":                                 "",
+			"conditions": []interface{}{
+				map[string]interface{}{
+					"type":    "Ready",
+					"status":  "True",
+					"reason":  "Operation complete",
+					"message": "",
+				},
+			},
+			"included_volumes": []string{"rootdisk"},
+			"excluded_volumes": []string{"cloudinitdisk"},
+			"volume_snapshot_statuses": []interface{}{
+				map[string]interface{}{
+					"volume_snapshot_name": "vmsnapshot-rootdisk",
+					"creation_time":        "2023-03-01T12:00:00Z",
+					"ready_to_use":         true,
+					"error":                "",
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,38 @@
+package utils
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceSchemaFromResourceSchema converts the schema of a resource into the schema of a
+// data source, where every attribute is computed from the object read from the cluster.
+func DataSourceSchemaFromResourceSchema(rs map[string]*schema.Schema) map[string]*schema.Schema {
+	ds := make(map[string]*schema.Schema, len(rs))
+	for k, v := range rs {
+		ds[k] = dataSourceSchemaFromResourceSchema(v)
+	}
+	return ds
+}
+
+func dataSourceSchemaFromResourceSchema(rs *schema.Schema) *schema.Schema {
+	ds := &schema.Schema{
+		Type:        rs.Type,
+		Description: rs.Description,
+		Sensitive:   rs.Sensitive,
+		Computed:    true,
+		Set:         rs.Set,
+	}
+
+	switch elem := rs.Elem.(type) {
+	case *schema.Resource:
+		ds.Elem = &schema.Resource{
+			Schema: DataSourceSchemaFromResourceSchema(elem.Schema),
+		}
+	case *schema.Schema:
+		ds.Elem = &schema.Schema{
+			Type: elem.Type,
+		}
+	}
+
+	return ds
+}
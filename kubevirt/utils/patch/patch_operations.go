@@ -111,7 +111,7 @@ func (o *ReplaceOperation) MarshalJSON() ([]byte, error) {
 
 func (o *ReplaceOperation) String() string {
 	b, _ := o.MarshalJSON()
-	return string(b)
+	return redact(b)
 }
 
 type AddOperation struct {
@@ -131,7 +131,7 @@ func (o *AddOperation) MarshalJSON() ([]byte, error) {
 
 func (o *AddOperation) String() string {
 	b, _ := o.MarshalJSON()
-	return string(b)
+	return redact(b)
 }
 
 type RemoveOperation struct {
@@ -152,3 +152,39 @@ func (o *RemoveOperation) String() string {
 	b, _ := o.MarshalJSON()
 	return string(b)
 }
+
+// sensitiveKeys are the JSON keys whose values are masked when operations are
+// printed, so that logging a patch doesn't leak them.
+var sensitiveKeys = map[string]bool{
+	"secretExtraHeaders": true,
+}
+
+func redact(b []byte) string {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return string(b)
+	}
+	out, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return string(b)
+	}
+	return string(out)
+}
+
+func redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if sensitiveKeys[k] {
+				v[k] = "(sensitive value)"
+				continue
+			}
+			v[k] = redactValue(e)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = redactValue(e)
+		}
+	}
+	return v
+}
@@ -2,6 +2,7 @@ package patch
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -165,3 +166,28 @@ func TestEscapeJsonPointer(t *testing.T) {
 		}
 	}
 }
+
+func TestOperationStringRedactsSensitiveKeys(t *testing.T) {
+	op := &AddOperation{
+		Path: "/spec/source",
+		Value: map[string]interface{}{
+			"http": map[string]interface{}{
+				"url":                "https://artifacts.example.com/fedora.qcow2",
+				"secretExtraHeaders": []string{"artifact-store-token"},
+			},
+		},
+	}
+
+	expected := `{"op":"add","path":"/spec/source","value":{"http":{"secretExtraHeaders":"(sensitive value)","url":"https://artifacts.example.com/fedora.qcow2"}}}`
+	if op.String() != expected {
+		t.Fatalf("Unexpected string.\nExpected: %s\nGiven:    %s\n", expected, op.String())
+	}
+
+	b, err := op.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), "artifact-store-token") {
+		t.Fatalf("MarshalJSON must keep the sensitive values, got %s", b)
+	}
+}
@@ -0,0 +1,61 @@
+package utils
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ForceNewSchema returns a copy of the schema where a change of any configurable
+// attribute, however deeply nested, replaces the resource.
+func ForceNewSchema(in map[string]*schema.Schema) map[string]*schema.Schema {
+	out := make(map[string]*schema.Schema, len(in))
+	for k, v := range in {
+		s := *v
+		if s.Optional || s.Required {
+			s.ForceNew = true
+		}
+		if elem, ok := s.Elem.(*schema.Resource); ok {
+			s.Elem = &schema.Resource{
+				Schema: ForceNewSchema(elem.Schema),
+			}
+		}
+		out[k] = &s
+	}
+	return out
+}
+
+// ServerDefaultedSchema returns a copy of the schema where every optional
+// attribute without a default, however deeply nested, is computed as well, so
+// that the values the API server defaults when they are left unset don't show
+// up as changes.
+func ServerDefaultedSchema(in map[string]*schema.Schema) map[string]*schema.Schema {
+	out := make(map[string]*schema.Schema, len(in))
+	for k, v := range in {
+		s := *v
+		if s.Optional && s.Default == nil && s.DefaultFunc == nil {
+			s.Computed = true
+		}
+		if elem, ok := s.Elem.(*schema.Resource); ok {
+			s.Elem = &schema.Resource{
+				Schema: ServerDefaultedSchema(elem.Schema),
+			}
+		}
+		out[k] = &s
+	}
+	return out
+}
+
+// SuppressEquivalentDuration suppresses the diff between durations which only differ in their
+// format, such as the configured "5m" and the "5m0s" read back from the API server.
+func SuppressEquivalentDuration(k, old, new string, d *schema.ResourceData) bool {
+	o, err := time.ParseDuration(old)
+	if err != nil {
+		return false
+	}
+	n, err := time.ParseDuration(new)
+	if err != nil {
+		return false
+	}
+	return o == n
+}
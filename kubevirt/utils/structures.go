@@ -4,6 +4,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	api "k8s.io/api/core/v1"
@@ -25,6 +26,14 @@ func BuildId(meta metav1.ObjectMeta) string {
 	return meta.Namespace + "/" + meta.Name
 }
 
+// FlattenTime formats the time as RFC 3339, an empty string if it isn't set.
+func FlattenTime(t *metav1.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
 func FlattenStringMap(m map[string]string) map[string]interface{} {
 	if m == nil {
 		return nil
@@ -76,6 +85,14 @@ func ExpandStringSlice(s []interface{}) []string {
 	return result
 }
 
+func FlattenStringSlice(s []string) []interface{} {
+	result := make([]interface{}, len(s), len(s))
+	for k, v := range s {
+		result[k] = v
+	}
+	return result
+}
+
 func FlattenByteMapToBase64Map(m map[string][]byte) map[string]string {
 	result := make(map[string]string)
 	for k, v := range m {
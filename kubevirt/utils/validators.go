@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -155,7 +156,7 @@ func validateResourceList(value interface{}, key string) (ws []string, es []erro
 	return
 }
 
-func validateResourceQuantity(value interface{}, key string) (ws []string, es []error) {
+func ValidateResourceQuantity(value interface{}, key string) (ws []string, es []error) {
 	if v, ok := value.(string); ok {
 		_, err := resource.ParseQuantity(v)
 		if err != nil {
@@ -165,6 +166,23 @@ func validateResourceQuantity(value interface{}, key string) (ws []string, es []
 	return
 }
 
+func ValidateDuration(value interface{}, key string) (ws []string, es []error) {
+	if v, ok := value.(string); ok {
+		if _, err := time.ParseDuration(v); err != nil {
+			es = append(es, fmt.Errorf("%s (%q): %s", key, v, err))
+		}
+	}
+	return
+}
+
+// ValidateTrue only accepts true, for flags which select an alternative, such as a source, when set.
+func ValidateTrue(value interface{}, key string) (ws []string, es []error) {
+	if v, ok := value.(bool); ok && !v {
+		es = append(es, fmt.Errorf("%s can only be set to true, remove it instead", key))
+	}
+	return
+}
+
 func validateNonNegativeInteger(value interface{}, key string) (ws []string, es []error) {
 	v := value.(int)
 	if v < 0 {